@@ -72,7 +72,7 @@ var listApiKeysCmd = &cobra.Command{
 			}
 		}
 
-		resp, r, err := apiKeyListRequest.Execute()
+		apiKeys, r, err := authApi.ListApiKeysPaged(apiKeyListRequest, ybmAuthClient.GetPageOptions(cmd))
 
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
@@ -84,12 +84,12 @@ var listApiKeysCmd = &cobra.Command{
 			Format: formatter.NewApiKeyFormat(viper.GetString("output")),
 		}
 
-		if len(resp.GetData()) < 1 {
+		if len(apiKeys) < 1 {
 			logrus.Info("No API Keys found")
 			return
 		}
 
-		apiKeyOutputList := *addAllowListNameToApiKeyData(&resp.Data, authApi)
+		apiKeyOutputList := *addAllowListNameToApiKeyData(&apiKeys, authApi)
 		formatter.ApiKeyWrite(apiKeyCtx, apiKeyOutputList)
 	},
 }
@@ -225,7 +225,7 @@ var createApiKeyCmd = &cobra.Command{
 		formatter.ApiKeyWrite(apiKeyCtx, apiKeyOutput)
 
 		fmt.Printf("\nAPI Key: %s \n", formatter.Colorize(resp.GetJwt(), formatter.GREEN_COLOR))
-		fmt.Printf("\nThe API key is only shown once after creation. Copy and store it securely.\n")
+		fmt.Fprintf(formatter.StatusOutput(), "\nThe API key is only shown once after creation. Copy and store it securely.\n")
 	},
 }
 
@@ -261,7 +261,7 @@ var revokeApiKeyCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The API key %s has been successfully revoked.\n", formatter.Colorize(name, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The API key %s has been successfully revoked.\n", formatter.Colorize(name, formatter.GREEN_COLOR))
 	},
 }
 
@@ -270,6 +270,7 @@ func init() {
 	listApiKeysCmd.Flags().SortFlags = false
 	listApiKeysCmd.Flags().String("name", "", "[OPTIONAL] To filter by API Key name.")
 	listApiKeysCmd.Flags().String("status", "", "[OPTIONAL] To filter by API Key status. Available options are ACTIVE, EXPIRED, REVOKED.")
+	ybmAuthClient.AddPaginationFlags(listApiKeysCmd)
 
 	ApiKeyCmd.AddCommand(createApiKeyCmd)
 	createApiKeyCmd.Flags().SortFlags = false
@@ -283,11 +284,13 @@ func init() {
 
 	createApiKeyCmd.Flags().String("network-allow-lists", "", "[OPTIONAL] The network allow lists(comma separated names) to assign to the API key.")
 	createApiKeyCmd.Flags().String("role-name", "", "[OPTIONAL] The name of the role to be assigned to the API Key. If not provided, an Admin API Key will be generated.")
+	ybmAuthClient.AddIdFlag(createApiKeyCmd, createApiKeyCmd.Flags(), "role-name", "role-id", "role")
 	createApiKeyCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 	ApiKeyCmd.AddCommand(revokeApiKeyCmd)
 	revokeApiKeyCmd.Flags().SortFlags = false
 	revokeApiKeyCmd.Flags().String("name", "", "[REQUIRED] The name of the API Key.")
 	revokeApiKeyCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(revokeApiKeyCmd, revokeApiKeyCmd.Flags(), "name", "key-id", "API key")
 	revokeApiKeyCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
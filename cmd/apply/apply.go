@@ -0,0 +1,529 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apply
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	pitrconfig "github.com/yugabyte/ybm-cli/cmd/cluster/pitr-config"
+	"github.com/yugabyte/ybm-cli/cmd/integration"
+	"github.com/yugabyte/ybm-cli/cmd/util"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var ApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Create or update resources from manifests",
+	Long: `Create or update resources from YAML or JSON manifests.
+
+Every manifest has a kind and a spec. The kinds are Cluster, NetworkAllowList,
+Vpc, VpcPeering, Integration, BackupPolicy and PitrConfig, applied in
+dependency order whatever the order of the files. The other kinds written by
+ybm export are only checked to be unchanged. Missing resources are
+created and the ones that differ are updated, waiting for each task to
+complete. Fields left out of a spec are not changed, while a list it sets,
+such as the regions of a cluster, replaces the live one. ${NAME} placeholders
+are replaced with the value of the environment variable NAME.
+
+  kind: NetworkAllowList
+  spec:
+    name: office
+    ip-addresses: [203.0.113.0/24]
+  ---
+  kind: Cluster
+  spec:
+    name: prod
+    cloud-provider: AWS
+    cluster-tier: Dedicated
+    fault-tolerance: ZONE
+    database-version: Production
+    regions:
+      - region: us-west-2
+        num-nodes: 3
+        num-cores: 4
+        disk-size-gb: 100
+    network-allow-lists: [office]
+    credentials:
+      username: admin
+      password: ${PROD_PASSWORD}
+  ---
+  kind: BackupPolicy
+  spec:
+    cluster: prod
+    retention-period-in-days: 8
+    full-backup-frequency-in-days: 1
+    enabled: true`,
+	Example: "ybm apply -f ./aeon",
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, _ := cmd.Flags().GetStringArray("filename")
+		manifests, err := manifest.Load(paths...)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		a := &applier{authApi: authApi, reader: manifest.NewReader(authApi)}
+		results := map[string]int{}
+		for _, m := range manifests {
+			result, err := a.apply(m)
+			if err != nil {
+				logrus.Fatalf("%s %s: %s", m.Kind, m.Spec.Key(), err)
+			}
+			results[result]++
+			fmt.Fprintf(formatter.StatusOutput(), "%s %s %s\n", m.Kind, formatter.Colorize(m.Spec.Key(), formatter.GREEN_COLOR), result)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "Apply complete: %d created, %d updated, %d unchanged\n", results[created], results[updated], results[unchanged])
+	},
+}
+
+const (
+	created   = "created"
+	updated   = "updated"
+	unchanged = "unchanged"
+)
+
+// applier creates or updates the resources of manifests
+type applier struct {
+	authApi *ybmAuthClient.AuthApiClient
+	reader  *manifest.Reader
+}
+
+// Apply creates the resource of m or updates it when it differs, and tells
+// which it did, for the commands copying resources through manifests
+func Apply(authApi *ybmAuthClient.AuthApiClient, m manifest.Manifest) (string, error) {
+	a := &applier{authApi: authApi, reader: manifest.NewReader(authApi)}
+	return a.apply(m)
+}
+
+// apply creates the resource of m or updates it when it differs, and tells
+// which it did
+func (a *applier) apply(m manifest.Manifest) (string, error) {
+	state, err := a.reader.Live(m)
+	if err != nil {
+		return "", apiError(nil, err)
+	}
+	if state == nil {
+		if m.Kind.ReadOnly() {
+			return "", fmt.Errorf("apply cannot create a %s, it only checks that it is unchanged", m.Kind)
+		}
+		return created, a.create(m)
+	}
+	changes := manifest.Diff(m.Spec, state.Spec)
+	if len(changes) == 0 {
+		return unchanged, nil
+	}
+	if m.Kind.ReadOnly() {
+		return "", fmt.Errorf("apply cannot change a %s, it only checks that it is unchanged: %s differ", m.Kind, strings.Join(manifest.Paths(changes), ", "))
+	}
+	for _, change := range changes {
+		logrus.Debugf("%s %s: %s changes from %q to %q", m.Kind, m.Spec.Key(), change.Path, change.Live, change.Desired)
+	}
+	return updated, a.update(m, state, changes)
+}
+
+func (a *applier) create(m manifest.Manifest) error {
+	switch spec := m.Spec.(type) {
+	case *manifest.NetworkAllowListSpec:
+		return a.createNetworkAllowList(spec)
+	case *manifest.VpcSpec:
+		return a.createVpc(spec)
+	case *manifest.VpcPeeringSpec:
+		return a.createVpcPeering(spec)
+	case *manifest.IntegrationSpec:
+		return a.createIntegration(spec)
+	case *manifest.ClusterSpec:
+		return a.createCluster(spec)
+	case *manifest.BackupPolicySpec:
+		return fmt.Errorf("the cluster %s does not exist or has no backup policy", spec.Cluster)
+	case *manifest.PitrConfigSpec:
+		return a.createPitrConfig(spec)
+	}
+	return fmt.Errorf("unsupported kind %s", m.Kind)
+}
+
+func (a *applier) update(m manifest.Manifest, state *manifest.State, changes []manifest.Change) error {
+	switch spec := m.Spec.(type) {
+	case *manifest.IntegrationSpec:
+		return a.updateIntegration(spec, state)
+	case *manifest.ClusterSpec:
+		return a.updateCluster(spec, state, changes)
+	case *manifest.BackupPolicySpec:
+		return a.updateBackupPolicy(spec, state)
+	case *manifest.PitrConfigSpec:
+		return a.updatePitrConfig(spec, state)
+	}
+	return fmt.Errorf("a %s cannot be updated in place, delete it first to change %s", m.Kind, strings.Join(manifest.Paths(changes), ", "))
+}
+
+func (a *applier) createNetworkAllowList(spec *manifest.NetworkAllowListSpec) error {
+	nalSpec := ybmclient.NetworkAllowListSpec{
+		Name:        spec.Name,
+		Description: spec.Description,
+		AllowList:   spec.IpAddresses,
+	}
+	_, r, err := a.authApi.CreateNetworkAllowList().NetworkAllowListSpec(nalSpec).Execute()
+	return apiError(r, err)
+}
+
+func (a *applier) createVpc(spec *manifest.VpcSpec) error {
+	if spec.GlobalCidr != "" {
+		if spec.CloudProvider != "GCP" {
+			return fmt.Errorf("global-cidr is only supported for GCP")
+		}
+		if len(spec.Regions) > 0 {
+			return fmt.Errorf("global-cidr and regions are mutually exclusive")
+		}
+	}
+	vpcRegionSpec := []ybmclient.VpcRegionSpec{}
+	for _, region := range spec.Regions {
+		regionSpec := *ybmclient.NewVpcRegionSpecWithDefaults()
+		regionSpec.SetRegion(region.Region)
+		if region.Cidr != "" {
+			if valid, err := util.ValidateCIDR(region.Cidr); !valid {
+				return err
+			}
+			regionSpec.SetCidr(region.Cidr)
+		}
+		vpcRegionSpec = append(vpcRegionSpec, regionSpec)
+	}
+	vpcSpec := *ybmclient.NewSingleTenantVpcSpec(spec.Name, ybmclient.CloudEnum(spec.CloudProvider), vpcRegionSpec)
+	if spec.GlobalCidr != "" {
+		if valid, err := util.ValidateCIDR(spec.GlobalCidr); !valid {
+			return err
+		}
+		vpcSpec.SetParentCidr(spec.GlobalCidr)
+	}
+	resp, r, err := a.authApi.CreateVpc().SingleTenantVpcRequest(*ybmclient.NewSingleTenantVpcRequest(vpcSpec)).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	msg := fmt.Sprintf("The VPC %s is being created", formatter.Colorize(spec.Name, formatter.GREEN_COLOR))
+	return a.wait(resp.Data.GetInfo().Id, "", ybmclient.TASKTYPEENUM_CREATE_VPC, msg)
+}
+
+func (a *applier) createVpcPeering(spec *manifest.VpcPeeringSpec) error {
+	appVpc := spec.ApplicationVpc
+	if appVpc.CloudProvider != "AWS" && appVpc.CloudProvider != "GCP" {
+		return fmt.Errorf("the cloud provider of the application VPC must be either GCP or AWS")
+	}
+	if appVpc.Project == "" || appVpc.Vpc == "" {
+		return fmt.Errorf("the project and the vpc of the application VPC are required")
+	}
+	if appVpc.CloudProvider == "AWS" && (appVpc.Region == "" || appVpc.Cidr == "") {
+		return fmt.Errorf("the region and the cidr of the application VPC are required for AWS")
+	}
+	applicationVPCSpec := ybmclient.NewCustomerVpcSpec(appVpc.Vpc, appVpc.Project, *ybmclient.NewVpcCloudInfo(ybmclient.CloudEnum(appVpc.CloudProvider)))
+	if appVpc.Region != "" {
+		applicationVPCSpec.CloudInfo.SetRegion(appVpc.Region)
+	}
+	if appVpc.Cidr != "" {
+		if valid, err := util.ValidateCIDR(appVpc.Cidr); !valid {
+			return err
+		}
+		applicationVPCSpec.SetCidr(appVpc.Cidr)
+	}
+	ybVpcId, err := a.authApi.GetVpcIdByName(spec.Vpc)
+	if err != nil {
+		return err
+	}
+	peeringSpec := *ybmclient.NewVpcPeeringSpec(ybVpcId, spec.Name, *applicationVPCSpec)
+	_, r, err := a.authApi.CreateVpcPeering().VpcPeeringSpec(peeringSpec).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	msg := fmt.Sprintf("The VPC Peering %s is being created", formatter.Colorize(spec.Name, formatter.GREEN_COLOR))
+	return a.wait(ybVpcId, "", ybmclient.TASKTYPEENUM_CREATE_VPC_PEERING, msg)
+}
+
+func (a *applier) integrationSpec(spec *manifest.IntegrationSpec) (*ybmclient.TelemetryProviderSpec, error) {
+	sinkTypeEnum, err := ybmclient.NewTelemetryProviderTypeEnumFromValue(strings.ToUpper(spec.Type))
+	if err != nil {
+		return nil, err
+	}
+	return integration.NewIntegrationSpec(spec.Name, *sinkTypeEnum, spec.Config)
+}
+
+func (a *applier) createIntegration(spec *manifest.IntegrationSpec) error {
+	integrationSpec, err := a.integrationSpec(spec)
+	if err != nil {
+		return err
+	}
+	_, r, err := a.authApi.CreateIntegration().TelemetryProviderSpec(*integrationSpec).Execute()
+	return apiError(r, err)
+}
+
+func (a *applier) updateIntegration(spec *manifest.IntegrationSpec, state *manifest.State) error {
+	integrationSpec, err := a.integrationSpec(spec)
+	if err != nil {
+		return err
+	}
+	_, r, err := a.authApi.UpdateIntegration(state.ID).TelemetryProviderSpec(*integrationSpec).Execute()
+	return apiError(r, err)
+}
+
+func (a *applier) createCluster(spec *manifest.ClusterSpec) error {
+	if spec.Credentials == nil || spec.Credentials.Username == "" || spec.Credentials.Password == "" {
+		return fmt.Errorf("credentials with a username and a password are required to create the cluster")
+	}
+	clusterSpec, err := a.authApi.BuildClusterSpec(spec.Options(), "")
+	if err != nil {
+		return err
+	}
+	username := base64.StdEncoding.EncodeToString([]byte(spec.Credentials.Username))
+	password := base64.StdEncoding.EncodeToString([]byte(spec.Credentials.Password))
+	dbCredentials := ybmclient.NewCreateClusterRequestEncryptedDbCredentialsWithDefaults()
+	dbCredentials.Ycql = *ybmclient.NewEncryptedDBCredentials(username, password)
+	dbCredentials.Ysql = *ybmclient.NewEncryptedDBCredentials(username, password)
+	createClusterRequest := ybmclient.NewCreateClusterRequest(*clusterSpec)
+	createClusterRequest.SetEncryptedDbCredentials(*dbCredentials)
+
+	resp, r, err := a.authApi.CreateCluster().CreateClusterRequest(*createClusterRequest).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	clusterID := resp.GetData().Info.Id
+	msg := fmt.Sprintf("The cluster %s is being created", formatter.Colorize(spec.Name, formatter.GREEN_COLOR))
+	if err := a.wait(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_CREATE_CLUSTER, msg); err != nil {
+		return err
+	}
+	if len(spec.NetworkAllowLists) > 0 {
+		return a.assignNetworkAllowLists(spec.Name, clusterID, spec.NetworkAllowLists)
+	}
+	return nil
+}
+
+func (a *applier) updateCluster(spec *manifest.ClusterSpec, state *manifest.State, changes []manifest.Change) error {
+	specChanged, allowListsChanged := false, false
+	for _, change := range changes {
+		if change.Path == "network-allow-lists" {
+			allowListsChanged = true
+		} else {
+			specChanged = true
+		}
+	}
+	if specChanged {
+		opts := withLiveValues(spec, state.Spec.(*manifest.ClusterSpec)).Options()
+		clusterSpec, err := a.authApi.BuildClusterSpec(opts, state.ID)
+		if err != nil {
+			return err
+		}
+		originalSpec := state.Data.(ybmclient.ClusterData).GetSpec()
+		clusterSpec.ClusterInfo.SetVersion(originalSpec.ClusterInfo.GetVersion())
+		_, r, err := a.authApi.EditCluster(state.ID).ClusterSpec(*clusterSpec).Execute()
+		if err != nil {
+			return apiError(r, err)
+		}
+		msg := fmt.Sprintf("The cluster %s is being updated", formatter.Colorize(spec.Name, formatter.GREEN_COLOR))
+		if err := a.wait(state.ID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_EDIT_CLUSTER, msg); err != nil {
+			return err
+		}
+	}
+	if allowListsChanged {
+		return a.assignNetworkAllowLists(spec.Name, state.ID, spec.NetworkAllowLists)
+	}
+	return nil
+}
+
+// withLiveValues completes the desired spec of a cluster with the live values
+// of the fields it leaves out, since an edit replaces the whole spec
+func withLiveValues(desired *manifest.ClusterSpec, live *manifest.ClusterSpec) *manifest.ClusterSpec {
+	merged := *desired
+	for field, value := range map[*string]string{
+		&merged.CloudProvider:   live.CloudProvider,
+		&merged.ClusterTier:     live.ClusterTier,
+		&merged.ClusterType:     live.ClusterType,
+		&merged.FaultTolerance:  live.FaultTolerance,
+		&merged.DatabaseVersion: live.DatabaseVersion,
+	} {
+		if *field == "" {
+			*field = value
+		}
+	}
+	if merged.NumFaultsToTolerate == nil {
+		merged.NumFaultsToTolerate = live.NumFaultsToTolerate
+	}
+	if desired.Regions == nil {
+		merged.Regions = live.Regions
+		return &merged
+	}
+	liveRegions := map[string]manifest.RegionSpec{}
+	for _, region := range live.Regions {
+		liveRegions[region.Region] = region
+	}
+	merged.Regions = make([]manifest.RegionSpec, 0, len(desired.Regions))
+	for _, region := range desired.Regions {
+		if liveRegion, ok := liveRegions[region.Region]; ok {
+			if region.NumNodes == 0 {
+				region.NumNodes = liveRegion.NumNodes
+			}
+			if region.Vpc == "" {
+				region.Vpc = liveRegion.Vpc
+			}
+			if region.NumCores == 0 {
+				region.NumCores = liveRegion.NumCores
+			}
+			if region.DiskSizeGb == 0 {
+				region.DiskSizeGb = liveRegion.DiskSizeGb
+			}
+			if region.DiskIops == 0 {
+				region.DiskIops = liveRegion.DiskIops
+			}
+			if region.BackupReplicationGcpTarget == "" {
+				region.BackupReplicationGcpTarget = liveRegion.BackupReplicationGcpTarget
+			}
+		}
+		merged.Regions = append(merged.Regions, region)
+	}
+	return &merged
+}
+
+func (a *applier) assignNetworkAllowLists(clusterName string, clusterID string, names []string) error {
+	allowListIds := make([]string, 0, len(names))
+	for _, name := range names {
+		allowListId, err := a.authApi.GetNetworkAllowListIdByName(name)
+		if err != nil {
+			return err
+		}
+		allowListIds = append(allowListIds, allowListId)
+	}
+	_, r, err := a.authApi.EditClusterNetworkAllowLists(clusterID, allowListIds).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	msg := fmt.Sprintf("The network allow lists of the cluster %s are being updated", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+	return a.wait(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_EDIT_ALLOW_LIST, msg)
+}
+
+func (a *applier) updateBackupPolicy(spec *manifest.BackupPolicySpec, state *manifest.State) error {
+	if spec.FullBackupFrequencyInDays != 0 && spec.CronExpression != "" {
+		return fmt.Errorf("full-backup-frequency-in-days and cron-expression are mutually exclusive")
+	}
+	schedule := state.Data.(ybmclient.BackupScheduleDataV2)
+	scheduleSpec := schedule.GetSpec()
+	if spec.RetentionPeriodInDays != 0 {
+		scheduleSpec.SetRetentionPeriodInDays(spec.RetentionPeriodInDays)
+	}
+	if spec.FullBackupFrequencyInDays != 0 {
+		scheduleSpec.SetTimeIntervalInDays(spec.FullBackupFrequencyInDays)
+		scheduleSpec.UnsetCronExpression()
+	}
+	if spec.CronExpression != "" {
+		scheduleSpec.SetCronExpression(spec.CronExpression)
+		scheduleSpec.UnsetTimeIntervalInDays()
+	}
+	if spec.IncrementalBackupFrequencyInMinutes != 0 {
+		scheduleSpec.SetIncrementalIntervalInMinutes(spec.IncrementalBackupFrequencyInMinutes)
+	}
+	if spec.Enabled != nil {
+		if *spec.Enabled {
+			scheduleSpec.SetState(ybmclient.SCHEDULESTATEENUM_ACTIVE)
+		} else {
+			scheduleSpec.SetState(ybmclient.SCHEDULESTATEENUM_PAUSED)
+		}
+	}
+	_, r, err := a.authApi.UpdateBackupPolicyV2(state.ClusterID, state.ID).ScheduleSpecV2(scheduleSpec).Execute()
+	return apiError(r, err)
+}
+
+func (a *applier) createPitrConfig(spec *manifest.PitrConfigSpec) error {
+	cluster, err := a.reader.Cluster(spec.Cluster)
+	if err != nil {
+		return apiError(nil, err)
+	}
+	if cluster == nil {
+		return fmt.Errorf("the cluster %s does not exist", spec.Cluster)
+	}
+	clusterID := cluster.Info.GetId()
+	namespacesResp, r, err := a.authApi.GetClusterNamespaces(clusterID).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	namespaceId := ""
+	for _, namespace := range namespacesResp.Data {
+		if namespace.GetName() == spec.NamespaceName && namespace.GetTableType() == pitrconfig.GetNamespaceTypeMap()[spec.NamespaceType] {
+			namespaceId = namespace.GetId()
+		}
+	}
+	if namespaceId == "" {
+		return fmt.Errorf("no %s namespace found with name %s in cluster %s", spec.NamespaceType, spec.NamespaceName, spec.Cluster)
+	}
+	pitrConfigSpec := *ybmclient.NewDatabasePitrConfigSpecWithDefaults()
+	pitrConfigSpec.SetDatabaseId(namespaceId)
+	pitrConfigSpec.SetRetentionPeriod(spec.RetentionPeriodInDays)
+	bulkPitrConfigSpec, err := a.authApi.CreateBulkPitrConfigSpec([]ybmclient.DatabasePitrConfigSpec{pitrConfigSpec})
+	if err != nil {
+		return err
+	}
+	_, r, err = a.authApi.CreatePitrConfig(clusterID).BulkCreateDatabasePitrConfigSpec(*bulkPitrConfigSpec).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	msg := fmt.Sprintf("The PITR Configuration for %s namespace %s is being created", spec.NamespaceType, formatter.Colorize(spec.NamespaceName, formatter.GREEN_COLOR))
+	return a.wait(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_BULK_ENABLE_DB_PITR, msg)
+}
+
+func (a *applier) updatePitrConfig(spec *manifest.PitrConfigSpec, state *manifest.State) error {
+	updateSpec := ybmclient.NewUpdateDatabasePitrConfigSpec(spec.RetentionPeriodInDays)
+	_, r, err := a.authApi.UpdatePitrConfig(state.ClusterID, state.ID).UpdateDatabasePitrConfigSpec(*updateSpec).Execute()
+	if err != nil {
+		return apiError(r, err)
+	}
+	msg := fmt.Sprintf("The PITR Configuration for %s namespace %s is being updated", spec.NamespaceType, formatter.Colorize(spec.NamespaceName, formatter.GREEN_COLOR))
+	return a.wait(state.ClusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_UPDATE_DB_PITR, msg)
+}
+
+// wait waits for the task of a change. Apply always waits since the next
+// resources may depend on this one.
+func (a *applier) wait(entityID string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, msg string) error {
+	returnStatus, err := a.authApi.WaitForTaskCompletion(entityID, entityType, taskType, []string{"FAILED", "SUCCEEDED"}, msg)
+	if err != nil {
+		return fmt.Errorf("error when getting task status: %s", err)
+	}
+	if returnStatus != "SUCCEEDED" {
+		return fmt.Errorf("Operation failed with error: %s", returnStatus)
+	}
+	return nil
+}
+
+// apiError returns the detail of an API error, nil without error
+func apiError(r *http.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+	if r != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+	}
+	return errors.New(ybmAuthClient.GetApiErrorDetails(err))
+}
+
+func init() {
+	ApplyCmd.Flags().StringArrayP("filename", "f", []string{}, "[REQUIRED] Manifest file, or directory of .yaml, .yml and .json manifest files. Repeat the flag to apply several.")
+	ApplyCmd.MarkFlagRequired("filename")
+}
@@ -0,0 +1,168 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	openapi "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Apply", func() {
+
+	var (
+		server          *ghttp.Server
+		statusCode      int
+		args            []string
+		responseAccount openapi.AccountResponse
+		responseProject openapi.AccountResponse
+		responseNAL     openapi.NetworkAllowListListResponse
+		manifestPath    string
+	)
+
+	writeManifest := func(content string) {
+		manifestPath = filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+		Expect(os.WriteFile(manifestPath, []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		args = os.Args
+		os.Args = []string{}
+		var err error
+		server, err = newGhttpServer(responseAccount, responseProject)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("YBM_HOST", fmt.Sprintf("http://%s", server.Addr()))
+		os.Setenv("YBM_APIKEY", "test-token")
+		statusCode = 200
+		err = loadJson("./test/fixtures/allow-list.json", &responseNAL)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("When applying a network allow list", func() {
+
+		It("should create the missing allow list", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: office
+  description: office
+  ip-addresses: [203.0.113.0/24]
+`)
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists"),
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists"),
+					ghttp.VerifyJSON(`{"name":"office","description":"office","allow_list":["203.0.113.0/24"]}`),
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, map[string]interface{}{"data": responseNAL.Data[0]}),
+				),
+			)
+			cmd := exec.Command(compiledCLIPath, "apply", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`NetworkAllowList .*office.* created`))
+			Expect(session.Err).Should(gbytes.Say(`Apply complete: 1 created, 0 updated, 0 unchanged`))
+			session.Kill()
+		})
+
+		It("should leave an identical allow list unchanged", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.42/32]
+`)
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists"),
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+				),
+			)
+			cmd := exec.Command(compiledCLIPath, "apply", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`NetworkAllowList .*device-ip-gween.* unchanged`))
+			Expect(session.Err).Should(gbytes.Say(`Apply complete: 0 created, 0 updated, 1 unchanged`))
+			session.Kill()
+		})
+
+		It("should refuse to change an existing allow list in place", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.43/32]
+`)
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists"),
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+				),
+			)
+			cmd := exec.Command(compiledCLIPath, "apply", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`NetworkAllowList device-ip-gween: a NetworkAllowList cannot be updated in place, delete it first to change ip-addresses`))
+			session.Kill()
+		})
+	})
+
+	Context("When the manifest is invalid", func() {
+
+		It("should reject an unknown kind", func() {
+			writeManifest(`kind: Database
+spec:
+  name: db
+`)
+			cmd := exec.Command(compiledCLIPath, "apply", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`unknown kind "Database"`))
+			session.Kill()
+		})
+
+		It("should reject an unknown field", func() {
+			writeManifest(`kind: Vpc
+spec:
+  name: vpc
+  cidr: 10.0.0.0/16
+`)
+			cmd := exec.Command(compiledCLIPath, "apply", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`field cidr not found`))
+			session.Kill()
+		})
+	})
+
+	AfterEach(func() {
+		os.Args = args
+		server.Close()
+	})
+})
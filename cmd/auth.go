@@ -40,7 +40,7 @@ var authCmd = &cobra.Command{
 
 		// If the feature flag is enabled, prompt the user for URL
 		if util.IsFeatureFlagEnabled(util.CONFIGURE_URL) {
-			fmt.Print("Enter Host (leave empty for default cloud.yugabyte.com): ")
+			fmt.Fprint(os.Stderr, "Enter Host (leave empty for default cloud.yugabyte.com): ")
 			fmt.Scanln(&host)
 			if strings.TrimSpace(host) == "" {
 				host = "cloud.yugabyte.com"
@@ -52,7 +52,7 @@ var authCmd = &cobra.Command{
 		viper.GetViper().Set("host", &host)
 
 		// Now prompt for the API key
-		fmt.Print("Enter API Key: ")
+		fmt.Fprint(os.Stderr, "Enter API Key: ")
 		data, err := term.ReadPassword(int(os.Stdin.Fd()))
 		if err != nil {
 			logrus.Fatalln("Could not read apiKey: ", err)
@@ -78,7 +78,10 @@ var authCmd = &cobra.Command{
 			logrus.Fatal(err)
 		}
 
-		authApi, _ := ybmAuthClient.NewAuthApiClientCustomUrlKey(url, apiKey)
+		authApi, err := ybmAuthClient.NewAuthApiClientCustomUrlKey(url, apiKey)
+		if err != nil {
+			logrus.Fatal(err)
+		}
 		_, r, err := authApi.Ping().Execute()
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
@@ -96,7 +99,7 @@ var authCmd = &cobra.Command{
 		err = viper.WriteConfig()
 		if err != nil {
 			if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-				fmt.Fprintln(os.Stdout, "No config was found a new one will be created.")
+				fmt.Fprintln(os.Stderr, "No config was found a new one will be created.")
 				//Try to create the file
 				err = viper.SafeWriteConfig()
 				if err != nil {
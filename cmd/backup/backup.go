@@ -17,6 +17,7 @@ package backup
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -25,8 +26,10 @@ import (
 	"github.com/yugabyte/ybm-cli/cmd/backup/policyv2"
 	backupUtil "github.com/yugabyte/ybm-cli/cmd/backup/util"
 	"github.com/yugabyte/ybm-cli/cmd/util"
+	"github.com/yugabyte/ybm-cli/internal/bulk"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/watch"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -58,17 +61,22 @@ var listBackupCmd = &cobra.Command{
 			}
 			listBackupRequest = listBackupRequest.ClusterId(clusterID)
 		}
-		resp, r, err := listBackupRequest.Execute()
+		err = watch.Run(cmd, args, func(out io.Writer) error {
+			backups, r, err := authApi.ListBackupsPaged(listBackupRequest, ybmAuthClient.GetPageOptions(cmd))
+			if err != nil {
+				logrus.Debugf("Full HTTP response: %v", r)
+				return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+			}
+			backupsCtx := formatter.Context{
+				Output: out,
+				Format: formatter.NewBackupFormat(viper.GetString("output")),
+			}
+
+			return formatter.BackupWrite(backupsCtx, backups)
+		})
 		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
-		backupsCtx := formatter.Context{
-			Output: os.Stdout,
-			Format: formatter.NewBackupFormat(viper.GetString("output")),
+			logrus.Fatal(err)
 		}
-
-		formatter.BackupWrite(backupsCtx, resp.GetData())
 	},
 }
 
@@ -112,10 +120,10 @@ var restoreBackupCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("Backup %v has been restored onto the cluster %v\n", formatter.Colorize(backupID, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "Backup %v has been restored onto the cluster %v\n", formatter.Colorize(backupID, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 			return
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -130,29 +138,21 @@ var createBackupCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 		authApi.GetInfo("", "")
-		clusterName, _ := cmd.Flags().GetString("cluster-name")
-		clusterID, err := authApi.GetClusterIdByName(clusterName)
-		if err != nil {
-			logrus.Fatal(err)
-		}
-
-		createBackupSpec := *ybmclient.NewBackupSpecWithDefaults()
-		createBackupSpec.SetClusterId(clusterID)
-		// Set default retention period to 1 day
-		retentionPeriod := int32(1)
-		if cmd.Flags().Changed("retention-period") {
-			retentionPeriod, _ = cmd.Flags().GetInt32("retention-period")
-			createBackupSpec.SetRetentionPeriodInDays(retentionPeriod)
-		} else {
-
-			createBackupSpec.SetRetentionPeriodInDays(retentionPeriod)
+		clusterNames, _ := cmd.Flags().GetStringArray("cluster-name")
+		clusterIDs := make([]string, len(clusterNames))
+		for i, clusterName := range clusterNames {
+			clusterIDs[i], err = authApi.GetClusterIdByName(clusterName)
+			if err != nil {
+				logrus.Fatal(err)
+			}
 		}
-		if cmd.Flags().Changed("description") {
-			description, _ := cmd.Flags().GetString("description")
-			createBackupSpec.SetDescription(description)
+		if len(clusterNames) > 1 {
+			createBackups(authApi, cmd, clusterNames, clusterIDs)
+			return
 		}
-		backupUtil.SetBackupSpecUseRoles(cmd, &createBackupSpec)
+		clusterName, clusterID := clusterNames[0], clusterIDs[0]
 
+		createBackupSpec := backupSpec(cmd, clusterID)
 		backupResp, response, err := authApi.CreateBackup().BackupSpec(createBackupSpec).Execute()
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", response)
@@ -170,7 +170,7 @@ var createBackupCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The backup for cluster %s has been created\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The backup for cluster %s has been created\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 			respC, r, err := authApi.GetBackup(*backupID).Execute()
 			if err != nil {
@@ -179,7 +179,7 @@ var createBackupCmd = &cobra.Command{
 			}
 			backupResp = respC
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		backupsCtx := formatter.Context{
@@ -191,6 +191,55 @@ var createBackupCmd = &cobra.Command{
 	},
 }
 
+func backupSpec(cmd *cobra.Command, clusterID string) ybmclient.BackupSpec {
+	createBackupSpec := *ybmclient.NewBackupSpecWithDefaults()
+	createBackupSpec.SetClusterId(clusterID)
+	// Set default retention period to 1 day
+	retentionPeriod := int32(1)
+	if cmd.Flags().Changed("retention-period") {
+		retentionPeriod, _ = cmd.Flags().GetInt32("retention-period")
+		createBackupSpec.SetRetentionPeriodInDays(retentionPeriod)
+	} else {
+		createBackupSpec.SetRetentionPeriodInDays(retentionPeriod)
+	}
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		createBackupSpec.SetDescription(description)
+	}
+	backupUtil.SetBackupSpecUseRoles(cmd, &createBackupSpec)
+	return createBackupSpec
+}
+
+// createBackups backs up several clusters, waiting for the backups together
+func createBackups(authApi *ybmAuthClient.AuthApiClient, cmd *cobra.Command, clusterNames []string, clusterIDs []string) {
+	operation := bulk.NewOperation(authApi)
+	backups := []ybmclient.BackupData{}
+	for i, clusterName := range clusterNames {
+		wait := ybmAuthClient.TaskWait{Name: clusterName, EntityType: ybmclient.ENTITYTYPEENUM_BACKUP, TaskType: ybmclient.TASKTYPEENUM_CREATE_BACKUP}
+		backupResp, response, err := authApi.CreateBackup().BackupSpec(backupSpec(cmd, clusterIDs[i])).Execute()
+		if err != nil {
+			logrus.Debugf("Full HTTP response: %v", response)
+			operation.Failed(wait, fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err)))
+			continue
+		}
+		wait.EntityId = backupResp.GetData().Info.GetId()
+		operation.Started(wait)
+		backups = append(backups, backupResp.GetData())
+		fmt.Fprintf(formatter.StatusOutput(), "The backup for cluster %s is being created\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+	}
+
+	if viper.GetBool("wait") {
+		operation.ExitOnFailure(operation.Wait())
+		return
+	}
+	backupsCtx := formatter.Context{
+		Output: os.Stdout,
+		Format: formatter.NewBackupFormat(viper.GetString("output")),
+	}
+	formatter.BackupWrite(backupsCtx, backups)
+	operation.ExitOnFailure(nil)
+}
+
 var deleteBackupCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete backup for a cluster in YugabyteDB Aeon",
@@ -218,7 +267,7 @@ var deleteBackupCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The backup %s is being queued for deletion.\n", formatter.Colorize(backupID, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The backup %s is being queued for deletion.\n", formatter.Colorize(backupID, formatter.GREEN_COLOR))
 	},
 }
 
@@ -261,17 +310,22 @@ var describeBackupCmd = &cobra.Command{
 func init() {
 	BackupCmd.AddCommand(listBackupCmd)
 	listBackupCmd.Flags().String("cluster-name", "", "[OPTIONAL] Name of the cluster to fetch backups.")
+	ybmAuthClient.AddIdFlag(listBackupCmd, listBackupCmd.Flags(), "cluster-name", "cluster-id", "cluster")
+	ybmAuthClient.AddPaginationFlags(listBackupCmd)
+	watch.AddFlag(listBackupCmd)
 
 	BackupCmd.AddCommand(restoreBackupCmd)
 	restoreBackupCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster to restore backups.")
 	restoreBackupCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(restoreBackupCmd, restoreBackupCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	restoreBackupCmd.Flags().String("backup-id", "", "[REQUIRED] ID of the backup to be restored.")
 	restoreBackupCmd.MarkFlagRequired("backup-id")
 	backupUtil.AddIncludeRolesFlag(restoreBackupCmd, "[OPTIONAL] Restore global YSQL roles and permissions from the backup. (Default: false)")
 
 	BackupCmd.AddCommand(createBackupCmd)
-	createBackupCmd.Flags().String("cluster-name", "", "[REQUIRED] Name for the cluster.")
+	createBackupCmd.Flags().StringArray("cluster-name", []string{}, "[REQUIRED] Name for the cluster. Repeat it to back up several clusters, which are waited for together.")
 	createBackupCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(createBackupCmd, createBackupCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	createBackupCmd.Flags().Int32("retention-period", 0, "[OPTIONAL] Retention period of the backup in days. (Default: 1)")
 	createBackupCmd.Flags().String("description", "", "[OPTIONAL] Description of the backup.")
 	backupUtil.AddIncludeRolesFlag(createBackupCmd, "[OPTIONAL] Include global YSQL roles and permissions in the backup. (Default: false)")
@@ -115,7 +115,7 @@ var enablePolicyCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Successfully enabled backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Successfully enabled backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 	},
 }
@@ -160,7 +160,7 @@ var disablePolicyCmd = &cobra.Command{
 			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
-		fmt.Printf("Successfully disabled backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Successfully disabled backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 	},
 }
@@ -242,7 +242,7 @@ var updatePolicyCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Successfully updated backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Successfully updated backup policy for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 	},
 }
@@ -255,16 +255,20 @@ func init() {
 
 	listPolicyCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster to list backup policies.")
 	listPolicyCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(listPolicyCmd, listPolicyCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 	enablePolicyCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster to enable backup policies.")
 	enablePolicyCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(enablePolicyCmd, enablePolicyCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	util.AddIncludeRolesFlag(enablePolicyCmd, "[OPTIONAL] Include global YSQL roles and permissions in scheduled backups. (Default: false)")
 
 	disablePolicyCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster to disable backup policies.")
 	disablePolicyCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(disablePolicyCmd, disablePolicyCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 	updatePolicyCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster to update backup policies.")
 	updatePolicyCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(updatePolicyCmd, updatePolicyCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	updatePolicyCmd.Flags().Int32("retention-period-in-days", 1, "[REQUIRED] Retention period of the backup in days.")
 	updatePolicyCmd.MarkFlagRequired("retention-period-in-days")
 	updatePolicyCmd.Flags().Int32("full-backup-frequency-in-days", 1, "[OPTIONAL] Frequency of full backup in days.")
@@ -458,7 +458,7 @@ var _ = Describe("Backup Replication", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being enabled`))
+				Expect(session.Err).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being enabled`))
 				Expect(session.Out).Should(gbytes.Say(`Overall State: ENABLED`))
 				Expect(server.ReceivedRequests()).Should(HaveLen(6))
 				session.Kill()
@@ -555,7 +555,7 @@ var _ = Describe("Backup Replication", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being enabled`))
+				Expect(session.Err).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being enabled`))
 				Expect(server.ReceivedRequests()).Should(HaveLen(6))
 				session.Kill()
 			})
@@ -615,7 +615,7 @@ var _ = Describe("Backup Replication", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being disabled`))
+				Expect(session.Err).Should(gbytes.Say(`GCP backup replication for cluster stunning-sole is being disabled`))
 				Expect(session.Out).Should(gbytes.Say(`Overall State: DISABLED`))
 				Expect(server.ReceivedRequests()).Should(HaveLen(6))
 				session.Kill()
@@ -767,7 +767,7 @@ var _ = Describe("Backup Replication", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`Resync triggered for all backup replication configs in cluster stunning-sole`))
+				Expect(session.Err).Should(gbytes.Say(`Resync triggered for all backup replication configs in cluster stunning-sole`))
 				Expect(server.ReceivedRequests()).Should(HaveLen(4))
 				session.Kill()
 			})
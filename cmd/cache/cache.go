@@ -0,0 +1,54 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/yugabyte/ybm-cli/internal/cache"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+)
+
+var CacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local cache",
+	Long:  "Manage the local cache of cloud regions, node configurations, tracks and resource permissions",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var clearCacheCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Clear the local cache",
+	Long:  "Remove every entry from the local cache, the next commands fetch fresh data from YugabyteDB Aeon",
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, err := cache.Dir()
+		if err != nil {
+			logrus.Fatalf("Could not locate the cache directory: %s", err)
+		}
+		if err := cache.New(dir, false).Clear(); err != nil {
+			logrus.Fatal(err)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "Cache %s cleared\n", dir)
+	},
+}
+
+func init() {
+	CacheCmd.AddCommand(clearCacheCmd)
+}
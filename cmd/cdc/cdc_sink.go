@@ -64,13 +64,13 @@ var listCdcSinkCmd = &cobra.Command{
 			cdcSinkRequest = cdcSinkRequest.Name(cdcSinkName)
 		}
 
-		resp, r, err := cdcSinkRequest.Execute()
+		cdcSinks, r, err := authApi.ListCdcSinksPaged(cdcSinkRequest, ybmAuthClient.GetPageOptions(cmd))
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		printCdcSinkOutput(resp.GetData())
+		printCdcSinkOutput(cdcSinks)
 	},
 }
 
@@ -203,7 +203,7 @@ var deleteCdcSinkCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Fprintf(os.Stdout, "CDC sink deleted successfully")
+		fmt.Fprintln(formatter.StatusOutput(), "CDC sink deleted successfully")
 	},
 }
 
@@ -212,6 +212,7 @@ func init() {
 
 	CDCSinkCmd.AddCommand(listCdcSinkCmd)
 	listCdcSinkCmd.Flags().String("name", "", "[OPTIONAL] Name of the CDC sink.")
+	ybmAuthClient.AddPaginationFlags(listCdcSinkCmd)
 
 	CDCSinkCmd.AddCommand(createCdcSinkCmd)
 	createCdcSinkCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC sink.")
@@ -230,6 +231,7 @@ func init() {
 	CDCSinkCmd.AddCommand(editCdcSinkCmd)
 	editCdcSinkCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC Sink.")
 	editCdcSinkCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(editCdcSinkCmd, editCdcSinkCmd.Flags(), "name", "sink-id", "CDC sink")
 	editCdcSinkCmd.Flags().String("new-name", "", "[OPTIONAL] Name of the new CDC Sink.")
 	editCdcSinkCmd.Flags().String("auth-type", "", "[OPTIONAL] Name of the new CDC Sink.")
 	editCdcSinkCmd.Flags().String("username", "", "[OPTIONAL] Username of the CDC Sink.")
@@ -238,5 +240,6 @@ func init() {
 	CDCSinkCmd.AddCommand(deleteCdcSinkCmd)
 	deleteCdcSinkCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC Sink.")
 	deleteCdcSinkCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(deleteCdcSinkCmd, deleteCdcSinkCmd.Flags(), "name", "sink-id", "CDC sink")
 
 }
@@ -62,19 +62,19 @@ var listCdcStreamCmd = &cobra.Command{
 			if err != nil {
 				logrus.Fatal(err)
 			}
-			cdcStreamRequest.ClusterId(clusterID)
+			cdcStreamRequest = cdcStreamRequest.ClusterId(clusterID)
 		}
 		cdcStreamName, _ := cmd.Flags().GetString("name")
 		if cdcStreamName != "" {
-			cdcStreamRequest.Name(cdcStreamName)
+			cdcStreamRequest = cdcStreamRequest.Name(cdcStreamName)
 		}
 
-		resp, r, err := cdcStreamRequest.Execute()
+		cdcStreams, r, err := authApi.ListCdcStreamsPaged(cdcStreamRequest, ybmAuthClient.GetPageOptions(cmd))
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
-		printCdcStreamOutput(resp.GetData())
+		printCdcStreamOutput(cdcStreams)
 	},
 }
 
@@ -133,9 +133,9 @@ var createCdcStreamCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The CDC stream %s has been created\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The CDC stream %s has been created\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		printCdcStreamOutput([]ybmclient.CdcStreamData{resp.GetData()})
@@ -194,12 +194,12 @@ var editCdcStreamCmd = &cobra.Command{
 					logrus.Fatalf("Operation failed with error: %s", returnStatus)
 				}
 			}
-			fmt.Printf("The CDC stream %s has been updated\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The CDC stream %s has been updated\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
 		} else {
 			if cmd.Flags().Changed("tables") {
-				fmt.Println(msg)
+				fmt.Fprintln(formatter.StatusOutput(), msg)
 			} else {
-				fmt.Printf("The CDC stream %s has been updated\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
+				fmt.Fprintf(formatter.StatusOutput(), "The CDC stream %s has been updated\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
 			}
 		}
 
@@ -245,9 +245,9 @@ var deleteCdcStreamCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The CDC stream %s has been deleted\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The CDC stream %s has been deleted\n", formatter.Colorize(cdcStreamName, formatter.GREEN_COLOR))
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -259,13 +259,16 @@ func init() {
 	CDCStreamCmd.AddCommand(listCdcStreamCmd)
 	listCdcStreamCmd.Flags().String("name", "", "[OPTIONAL] Name of the CDC Stream.")
 	listCdcStreamCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the Cluster.")
+	ybmAuthClient.AddPaginationFlags(listCdcStreamCmd)
 	listCdcStreamCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(listCdcStreamCmd, listCdcStreamCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 	CDCStreamCmd.AddCommand(createCdcStreamCmd)
 	createCdcStreamCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC Stream.")
 	createCdcStreamCmd.MarkFlagRequired("name")
 	createCdcStreamCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the Cluster.")
 	createCdcStreamCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(createCdcStreamCmd, createCdcStreamCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	createCdcStreamCmd.Flags().StringArray("tables", []string{}, "[REQUIRED] Database tables the CDC Stream will listen to.")
 	createCdcStreamCmd.MarkFlagRequired("tables")
 	createCdcStreamCmd.Flags().String("sink", "", "[REQUIRED] Destination sink for the CDC Stream.")
@@ -278,15 +281,19 @@ func init() {
 	CDCStreamCmd.AddCommand(editCdcStreamCmd)
 	editCdcStreamCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC Stream.")
 	editCdcStreamCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(editCdcStreamCmd, editCdcStreamCmd.Flags(), "name", "stream-id", "CDC stream")
 	editCdcStreamCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the Cluster.")
 	editCdcStreamCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(editCdcStreamCmd, editCdcStreamCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	editCdcStreamCmd.Flags().String("new-name", "", "[OPTIONAL] Updated name of the CDC Stream.")
 	editCdcStreamCmd.Flags().StringArray("tables", []string{}, "[OPTIONAL] Tables the Cdc Stream will listen to.")
 
 	CDCStreamCmd.AddCommand(deleteCdcStreamCmd)
 	deleteCdcStreamCmd.Flags().String("name", "", "[REQUIRED] Name of the CDC Stream.")
 	deleteCdcStreamCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(deleteCdcStreamCmd, deleteCdcStreamCmd.Flags(), "name", "stream-id", "CDC stream")
 	deleteCdcStreamCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the Cluster.")
 	deleteCdcStreamCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(deleteCdcStreamCmd, deleteCdcStreamCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 }
@@ -93,7 +93,7 @@ var enableDbAuditLoggingCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DB audit logging has been enabled on the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB audit logging has been enabled on the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 			respC, r, err := authApi.ListDbAuditExporterConfig(clusterId).Execute()
 			if err != nil {
@@ -102,7 +102,7 @@ var enableDbAuditLoggingCmd = &cobra.Command{
 			}
 			respData = respC.GetData()[0]
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		formatter.DbAuditLoggingWriteFull(respData, integrationName)
@@ -130,7 +130,7 @@ var updateDbAuditLoggingCmd = &cobra.Command{
 			logrus.Fatal(err)
 		}
 
-		integrationId, err := getIntegrationIdFromName(integrationName, authApi)
+		integrationId, err := authApi.GetIntegrationIdFromName(integrationName)
 
 		if err != nil {
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
@@ -162,7 +162,7 @@ var updateDbAuditLoggingCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DB audit logging configuration has been updated for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB audit logging configuration has been updated for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 			respC, r, err := authApi.ListDbAuditExporterConfig(clusterId).Execute()
 			if err != nil {
@@ -171,7 +171,7 @@ var updateDbAuditLoggingCmd = &cobra.Command{
 			}
 			respData = respC.GetData()[0]
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 		formatter.DbAuditLoggingWriteFull(respData, integrationName)
 	},
@@ -203,7 +203,7 @@ var describeDbAuditLoggingCmd = &cobra.Command{
 		}
 
 		if len(resp.GetData()) < 1 {
-			fmt.Println("No DB Audit Logs Exporter found")
+			fmt.Fprintln(formatter.StatusOutput(), "No DB Audit Logs Exporter found")
 			return
 		}
 
@@ -262,10 +262,10 @@ var disableDbAuditLoggingCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DB audit logging has been disabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB audit logging has been disabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 			return
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -275,6 +275,7 @@ func init() {
 	enableDbAuditLoggingCmd.Flags().SortFlags = false
 	enableDbAuditLoggingCmd.Flags().String("integration-name", "", "[REQUIRED] Name of the Integration")
 	enableDbAuditLoggingCmd.MarkFlagRequired("integration-name")
+	ybmAuthClient.AddIdFlag(enableDbAuditLoggingCmd, enableDbAuditLoggingCmd.Flags(), "integration-name", "integration-id", "integration")
 	enableDbAuditLoggingCmd.Flags().StringToString("ysql-config", nil, `[REQUIRED] The ysql config to setup DB audit logging
 	Please provide key value pairs as follows:
 	log_catalog=<boolean>,log_level=<LOG_LEVEL>,log_client=<boolean>,log_parameter=<boolean>,
@@ -292,6 +293,7 @@ func init() {
 	updateDbAuditLoggingCmd.Flags().SortFlags = false
 	updateDbAuditLoggingCmd.Flags().String("integration-name", "", "[REQUIRED] Name of the Integration")
 	updateDbAuditLoggingCmd.MarkFlagRequired("integration-name")
+	ybmAuthClient.AddIdFlag(updateDbAuditLoggingCmd, updateDbAuditLoggingCmd.Flags(), "integration-name", "integration-id", "integration")
 	updateDbAuditLoggingCmd.Flags().StringToString("ysql-config", nil, `[REQUIRED] The ysql config to setup DB audit logging
 	Please provide key value pairs as follows:
 	log_catalog=<boolean>,log_level=<LOG_LEVEL>,log_client=<boolean>,log_parameter=<boolean>,
@@ -307,21 +309,6 @@ func init() {
 	disableDbAuditLoggingCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
 
-func getIntegrationIdFromName(integrationName string, authApi *ybmAuthClient.AuthApiClient) (string, error) {
-	integration, _, err := authApi.ListIntegrations().Name(integrationName).Execute()
-	if err != nil {
-		return "", err
-	}
-
-	integrationData := integration.GetData()
-
-	if len(integrationData) == 0 {
-		return "", fmt.Errorf("no integrations found with name: %s%s", integrationName, "\n")
-	}
-
-	return integrationData[0].GetInfo().Id, nil
-}
-
 func setDbAuditLogsExporterSpec(ysqlConfigMap map[string]string, statementClasses string, integrationId string) (*ybmclient.DbAuditExporterConfigSpec, error) {
 	log_catalog := ysqlConfigMap["log_catalog"]
 	log_client := ysqlConfigMap["log_client"]
@@ -163,9 +163,9 @@ func modifyBackupReplicationAndDisplay(
 		if returnStatus != "SUCCEEDED" {
 			return fmt.Errorf("operation failed with error: %s", returnStatus)
 		}
-		fmt.Printf(successMsg+"\n\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), successMsg+"\n\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 	} else {
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	}
 
 	updatedConfigResp, resp, err := authApi.GetGcpBackupReplicationConfig(clusterId).Execute()
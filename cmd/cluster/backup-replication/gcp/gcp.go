@@ -17,6 +17,7 @@ package gcp
 
 import (
 	"github.com/spf13/cobra"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 )
 
 var ClusterName string
@@ -34,6 +35,7 @@ var GcpCmd = &cobra.Command{
 func init() {
 	GcpCmd.PersistentFlags().StringVarP(&ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	GcpCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(GcpCmd, GcpCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	GcpCmd.AddCommand(describeGcpCmd)
 	describeGcpCmd.Flags().BoolVar(&showAll, "show-all", false, "Show all configurations including scheduled for expiry and removed regions")
@@ -49,6 +49,6 @@ var syncGcpCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Resync triggered for all backup replication configs in cluster %s\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Resync triggered for all backup replication configs in cluster %s\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 	},
 }
@@ -0,0 +1,124 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/bulk"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// clusterOperation starts a task on a cluster, such as pausing it
+type clusterOperation struct {
+	// done completes the messages: the cluster is being <done>, has been <done>
+	done     string
+	taskType ybmclient.TaskTypeEnum
+	start    func(authApi *ybmAuthClient.AuthApiClient, clusterID string) (ybmclient.ClusterData, *http.Response, error)
+}
+
+// runClusterOperation runs op on every --cluster-name. Several clusters are
+// waited for together, with a summary of the operations.
+func runClusterOperation(cmd *cobra.Command, op clusterOperation) {
+	authApi, err := ybmAuthClient.NewAuthApiClient()
+	if err != nil {
+		logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+	}
+	authApi.GetInfo("", "")
+	clusterNames, _ := cmd.Flags().GetStringArray("cluster-name")
+	clusterIDs := make([]string, len(clusterNames))
+	for i, clusterName := range clusterNames {
+		clusterIDs[i], err = authApi.GetClusterIdByName(clusterName)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+	}
+	if len(clusterNames) > 1 {
+		runBulkClusterOperation(authApi, op, clusterNames, clusterIDs)
+		return
+	}
+	clusterName, clusterID := clusterNames[0], clusterIDs[0]
+
+	data, r, err := op.start(authApi, clusterID)
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+	}
+	clusterData := []ybmclient.ClusterData{data}
+	msg := fmt.Sprintf("The cluster %s is being %s", formatter.Colorize(clusterName, formatter.GREEN_COLOR), op.done)
+
+	if viper.GetBool("wait") {
+		returnStatus, err := authApi.WaitForTaskCompletion(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, op.taskType, []string{"FAILED", "SUCCEEDED"}, msg)
+		if err != nil {
+			logrus.Fatalf("error when getting task status: %s", err)
+		}
+		if returnStatus != "SUCCEEDED" {
+			logrus.Fatalf("Operation failed with error: %s", returnStatus)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR), op.done)
+
+		respC, r, err := authApi.GetCluster(clusterID).Execute()
+		if err != nil {
+			logrus.Debugf("Full HTTP response: %v", r)
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		clusterData = []ybmclient.ClusterData{respC.GetData()}
+	} else {
+		fmt.Fprintln(formatter.StatusOutput(), msg)
+	}
+
+	clustersCtx := formatter.Context{
+		Output: os.Stdout,
+		Format: formatter.NewClusterFormat(viper.GetString("output")),
+	}
+
+	formatter.ClusterWrite(clustersCtx, clusterData)
+}
+
+func runBulkClusterOperation(authApi *ybmAuthClient.AuthApiClient, op clusterOperation, clusterNames []string, clusterIDs []string) {
+	operation := bulk.NewOperation(authApi)
+	clusterData := []ybmclient.ClusterData{}
+	for i, clusterName := range clusterNames {
+		wait := ybmAuthClient.TaskWait{Name: clusterName, EntityId: clusterIDs[i], EntityType: ybmclient.ENTITYTYPEENUM_CLUSTER, TaskType: op.taskType}
+		data, r, err := op.start(authApi, clusterIDs[i])
+		if err != nil {
+			logrus.Debugf("Full HTTP response: %v", r)
+			operation.Failed(wait, fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err)))
+			continue
+		}
+		operation.Started(wait)
+		clusterData = append(clusterData, data)
+		fmt.Fprintf(formatter.StatusOutput(), "The cluster %s is being %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR), op.done)
+	}
+
+	if viper.GetBool("wait") {
+		operation.ExitOnFailure(operation.Wait())
+		return
+	}
+	clustersCtx := formatter.Context{
+		Output: os.Stdout,
+		Format: formatter.NewClusterFormat(viper.GetString("output")),
+	}
+	formatter.ClusterWrite(clustersCtx, clusterData)
+	operation.ExitOnFailure(nil)
+}
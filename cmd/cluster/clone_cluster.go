@@ -0,0 +1,430 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/cmd/apply"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var cloneClusterCmd = &cobra.Command{
+	Use:   "clone",
+	Short: "Create a cluster with the configuration of another",
+	Long: `Create a cluster with the regions, node sizes, tier, fault tolerance and
+database version of a source cluster, then copy its network allow lists,
+connection pooling, DB query and audit logging, PITR configs, backup policy and
+metrics exporter.
+
+The clone is created in the project of the source, in another project of the
+account with --project-id, or in another account with --profile, the path of a
+ybm-cli config file holding the API key and host of that account. The VPCs and
+network allow lists of the source are created there when missing, and the
+integrations used by the logging and the metrics exporter must already exist
+with the same names.
+
+Every step waits for its task to complete. The settings that cannot be copied,
+such as a customer managed key, are listed at the end.`,
+	Example: "ybm cluster clone --source prod --name staging --credentials username=admin,password=<password>",
+	Run: func(cmd *cobra.Command, args []string) {
+		sourceName, _ := cmd.Flags().GetString("source")
+		name, _ := cmd.Flags().GetString("name")
+		credentials, _ := cmd.Flags().GetStringToString("credentials")
+		if credentials["username"] == "" || credentials["password"] == "" {
+			logrus.Fatalln("The credentials must have a username and a password")
+		}
+
+		sourceApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		sourceApi.GetInfo("", "")
+		targetApi, err := cloneTargetClient(cmd, sourceApi)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		c := &cloner{
+			sourceApi:    sourceApi,
+			targetApi:    targetApi,
+			sourceReader: manifest.NewReader(sourceApi),
+			sameProject:  sourceApi.AccountID == targetApi.AccountID && sourceApi.ProjectID == targetApi.ProjectID,
+		}
+		source, err := sourceApi.GetClusterByName(sourceName)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		existing, err := manifest.NewReader(targetApi).Cluster(name)
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		if existing != nil {
+			logrus.Fatalf("A cluster named %s already exists", name)
+		}
+
+		clusterID, err := c.createCluster(source, name, credentials)
+		if err != nil {
+			logrus.Fatalf("Could not create the cluster %s: %s", name, err)
+		}
+		c.copySettings(source, name, clusterID)
+
+		fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been cloned from %s\n", formatter.Colorize(name, formatter.GREEN_COLOR), formatter.Colorize(source.Spec.Name, formatter.GREEN_COLOR))
+		if len(c.notCopied) > 0 {
+			fmt.Fprintln(formatter.StatusOutput(), "Not copied:")
+			for _, reason := range c.notCopied {
+				fmt.Fprintf(formatter.StatusOutput(), "  - %s\n", reason)
+			}
+		}
+
+		respC, r, err := targetApi.GetCluster(clusterID).Execute()
+		if err != nil {
+			logrus.Debugf("Full HTTP response: %v", r)
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		clustersCtx := formatter.Context{
+			Output: os.Stdout,
+			Format: formatter.NewClusterFormat(viper.GetString("output")),
+		}
+		formatter.ClusterWrite(clustersCtx, []ybmclient.ClusterData{respC.GetData()})
+	},
+}
+
+// cloneTargetClient returns the client of the account and project the clone is
+// created in, the source client when neither --profile nor --project-id is given
+func cloneTargetClient(cmd *cobra.Command, sourceApi *ybmAuthClient.AuthApiClient) (*ybmAuthClient.AuthApiClient, error) {
+	if !cmd.Flags().Changed("profile") && !cmd.Flags().Changed("project-id") {
+		return sourceApi, nil
+	}
+	apiKey := viper.GetString("apiKey")
+	host := viper.GetString("host")
+	accountID := sourceApi.AccountID
+	if cmd.Flags().Changed("profile") {
+		profile, _ := cmd.Flags().GetString("profile")
+		config := viper.New()
+		config.SetConfigFile(profile)
+		config.SetConfigType("yaml")
+		if err := config.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("could not read the profile %s: %w", profile, err)
+		}
+		if apiKey = config.GetString("apiKey"); apiKey == "" {
+			return nil, fmt.Errorf("the profile %s has no apiKey", profile)
+		}
+		if config.IsSet("host") {
+			host = config.GetString("host")
+		}
+		// The account is the one of the API key of the profile
+		accountID = ""
+	}
+	url, err := ybmAuthClient.ParseURL(host)
+	if err != nil {
+		return nil, err
+	}
+	targetApi, err := ybmAuthClient.NewAuthApiClientCustomUrlKey(url, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	projectID, _ := cmd.Flags().GetString("project-id")
+	targetApi.GetInfo(accountID, projectID)
+	return targetApi, nil
+}
+
+// cloner copies a cluster and its settings, reading them with the source client
+// and creating them with the target client
+type cloner struct {
+	sourceApi    *ybmAuthClient.AuthApiClient
+	targetApi    *ybmAuthClient.AuthApiClient
+	sourceReader *manifest.Reader
+	sameProject  bool
+	// notCopied lists the settings of the source that could not be copied, and why
+	notCopied []string
+}
+
+// createCluster creates the clone with the spec of the source, copying first
+// the VPCs it needs when the clone is in another project, and returns its ID
+func (c *cloner) createCluster(source ybmclient.ClusterData, name string, credentials map[string]string) (string, error) {
+	spec, err := c.sourceReader.ClusterSpec(source)
+	if err != nil {
+		return "", err
+	}
+	if !c.sameProject {
+		for _, region := range spec.Regions {
+			if region.Vpc == "" {
+				continue
+			}
+			if err := c.copy(manifest.KindVpc, &manifest.VpcSpec{Name: region.Vpc}, nil); err != nil {
+				return "", fmt.Errorf("could not copy the VPC %s: %s", region.Vpc, err)
+			}
+		}
+	}
+
+	opts := spec.Options()
+	opts.Name = name
+	sourceSpec := source.GetSpec()
+	if len(sourceSpec.ClusterRegionInfo) > 1 {
+		for _, regionInfo := range sourceSpec.ClusterRegionInfo {
+			region := regionInfo.PlacementInfo.CloudInfo.GetRegion()
+			if regionInfo.GetIsAffinitized() && opts.FaultTolerance == "REGION" {
+				opts.PreferredRegion = region
+			}
+			if regionInfo.GetIsDefault() && opts.ClusterType == "GEO_PARTITIONED" {
+				opts.DefaultRegion = region
+			}
+		}
+	}
+	clusterSpec, err := c.targetApi.BuildClusterSpec(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	dbCredentials := ybmclient.NewCreateClusterRequestEncryptedDbCredentialsWithDefaults()
+	dbCredentials.Ycql = *ybmclient.NewEncryptedDBCredentials(encodeBase64(credentials["username"]), encodeBase64(credentials["password"]))
+	dbCredentials.Ysql = *ybmclient.NewEncryptedDBCredentials(encodeBase64(credentials["username"]), encodeBase64(credentials["password"]))
+	createClusterRequest := ybmclient.NewCreateClusterRequest(*clusterSpec)
+	createClusterRequest.SetEncryptedDbCredentials(*dbCredentials)
+	if source.Info.GetIsConnectionPoolingEnabled() {
+		createClusterRequest.SetFeatures([]ybmclient.CreateClusterFeatureEnum{ybmclient.CREATECLUSTERFEATUREENUM_ENABLE_CONNECTION_POOLING})
+	}
+
+	resp, r, err := c.targetApi.CreateCluster().CreateClusterRequest(*createClusterRequest).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return "", fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+	clusterID := resp.GetData().Info.Id
+	msg := fmt.Sprintf("The cluster %s is being created", formatter.Colorize(name, formatter.GREEN_COLOR))
+	if err := c.wait(clusterID, ybmclient.TASKTYPEENUM_CREATE_CLUSTER, msg); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been created\n", formatter.Colorize(name, formatter.GREEN_COLOR))
+
+	c.assignNetworkAllowLists(name, clusterID, spec.NetworkAllowLists)
+	return clusterID, nil
+}
+
+// copySettings copies the settings of the source applied to a running cluster.
+// A setting that fails is reported, the others are still copied.
+func (c *cloner) copySettings(source ybmclient.ClusterData, name string, clusterID string) {
+	if state, err := c.sourceReader.ClusterSettings(manifest.KindClusterEncryption, source); err != nil {
+		c.report("encryption", err)
+	} else if state != nil {
+		c.notCopied = append(c.notCopied, "encryption: the credentials of the customer managed key cannot be read, set them with ybm cluster encryption update")
+	}
+
+	if state, err := c.sourceReader.ClusterSettings(manifest.KindDbQueryLogging, source); err != nil {
+		c.report("DB query logging", err)
+	} else if state != nil {
+		c.report("DB query logging", c.enableDbQueryLogging(state, name, clusterID))
+	}
+
+	if state, err := c.sourceReader.ClusterSettings(manifest.KindDbAuditLogging, source); err != nil {
+		c.report("DB audit logging", err)
+	} else if state != nil {
+		c.report("DB audit logging", c.enableDbAuditLogging(state, name, clusterID))
+	}
+
+	pitrResp, r, err := c.sourceApi.ListClusterPitrConfigs(source.Info.GetId()).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		c.report("PITR configs", fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err)))
+	}
+	for _, pitrConfig := range pitrResp.GetData() {
+		spec := manifest.PitrConfigSpecOf(name, pitrConfig)
+		what := fmt.Sprintf("PITR config of the %s namespace %s", spec.NamespaceType, spec.NamespaceName)
+		c.report(what, c.apply(manifest.KindPitrConfig, spec))
+	}
+
+	policy, err := c.sourceReader.Live(manifest.Manifest{Kind: manifest.KindBackupPolicy, Spec: &manifest.BackupPolicySpec{Cluster: source.Spec.Name}})
+	if err != nil {
+		c.report("backup policy", err)
+	} else if policy != nil {
+		spec := policy.Spec.(*manifest.BackupPolicySpec)
+		spec.Cluster = name
+		c.report("backup policy", c.apply(manifest.KindBackupPolicy, spec))
+	}
+
+	if state, err := c.sourceReader.ClusterSettings(manifest.KindMetricsExporter, source); err != nil {
+		c.report("metrics exporter", err)
+	} else if state != nil {
+		c.report("metrics exporter", c.assignMetricsExporter(state, name, clusterID))
+	}
+}
+
+// assignMetricsExporter assigns to the clone the metrics exporter of the source,
+// found in the target by name
+func (c *cloner) assignMetricsExporter(state *manifest.State, name string, clusterID string) error {
+	exporter, _ := state.Spec.(*manifest.ResourceSpec).Settings["exporter"].(string)
+	targetConfig, err := c.targetApi.GetConfigByName(exporter)
+	if err != nil {
+		return fmt.Errorf("the metrics exporter %s: %s", exporter, ybmAuthClient.GetApiErrorDetails(err))
+	}
+	spec := ybmclient.NewMetricsExporterClusterConfigurationSpec(targetConfig.GetInfo().Id)
+	_, r, err := c.targetApi.AssociateMetricsExporterWithCluster(clusterID).MetricsExporterClusterConfigurationSpec(*spec).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+	fmt.Fprintf(formatter.StatusOutput(), "Assigning Metrics Exporter Config %s with cluster %s\n", formatter.Colorize(exporter, formatter.GREEN_COLOR), formatter.Colorize(name, formatter.GREEN_COLOR))
+	return nil
+}
+
+// assignNetworkAllowLists assigns the allow lists of the source to the clone,
+// copying them first when the clone is in another project
+func (c *cloner) assignNetworkAllowLists(name string, clusterID string, allowLists []string) {
+	allowListIds := []string{}
+	for _, allowList := range allowLists {
+		if !c.sameProject {
+			if err := c.copy(manifest.KindNetworkAllowList, &manifest.NetworkAllowListSpec{Name: allowList}, nil); err != nil {
+				c.report("network allow list "+allowList, err)
+				continue
+			}
+		}
+		allowListId, err := c.targetApi.GetNetworkAllowListIdByName(allowList)
+		if err != nil {
+			c.report("network allow list "+allowList, err)
+			continue
+		}
+		allowListIds = append(allowListIds, allowListId)
+	}
+	if len(allowListIds) == 0 {
+		return
+	}
+	_, r, err := c.targetApi.EditClusterNetworkAllowLists(clusterID, allowListIds).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		c.report("network allow lists", fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err)))
+		return
+	}
+	msg := fmt.Sprintf("The network allow lists of the cluster %s are being updated", formatter.Colorize(name, formatter.GREEN_COLOR))
+	c.report("network allow lists", c.wait(clusterID, ybmclient.TASKTYPEENUM_EDIT_ALLOW_LIST, msg))
+}
+
+func (c *cloner) enableDbQueryLogging(state *manifest.State, name string, clusterID string) error {
+	config := state.Data.(ybmclient.PgLogExporterConfigData)
+	exporterID, err := c.exporterID(state)
+	if err != nil {
+		return err
+	}
+	_, r, err := c.targetApi.EnableDbQueryLogging(clusterID).PgLogExporterConfigSpec(
+		ybmclient.PgLogExporterConfigSpec{ExportConfig: config.Spec.ExportConfig, ExporterId: exporterID}).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+	msg := fmt.Sprintf("DB query logging is being enabled for cluster %s", formatter.Colorize(name, formatter.GREEN_COLOR))
+	return c.wait(clusterID, ybmclient.TASKTYPEENUM_ENABLE_DATABASE_QUERY_LOGGING, msg)
+}
+
+func (c *cloner) enableDbAuditLogging(state *manifest.State, name string, clusterID string) error {
+	resp, r, err := c.sourceApi.ListDbAuditExporterConfig(state.ClusterID).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+	if len(resp.GetData()) == 0 {
+		return fmt.Errorf("the configuration of the source has been removed")
+	}
+	exporterID, err := c.exporterID(state)
+	if err != nil {
+		return err
+	}
+	spec := resp.GetData()[0].Spec
+	spec.ExporterId = exporterID
+	_, r, err = c.targetApi.AssignDbAuditLogsExporterConfig(clusterID).DbAuditExporterConfigSpec(spec).Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+	msg := fmt.Sprintf("DB audit logging is being enabled for cluster %s", formatter.Colorize(name, formatter.GREEN_COLOR))
+	return c.wait(clusterID, ybmclient.TASKTYPEENUM_ENABLE_DATABASE_AUDIT_LOGGING, msg)
+}
+
+// exporterID returns the ID in the target of the integration a logging setting
+// exports to, found by name
+func (c *cloner) exporterID(state *manifest.State) (string, error) {
+	exporter, _ := state.Spec.(*manifest.ResourceSpec).Settings["exporter"].(string)
+	exporterID, err := c.targetApi.GetIntegrationIdFromName(exporter)
+	if err != nil {
+		return "", fmt.Errorf("the integration %s: %s", exporter, ybmAuthClient.GetApiErrorDetails(err))
+	}
+	return exporterID, nil
+}
+
+// copy applies to the target the live spec of a resource of the source, spec
+// naming the resource and edit changing the copy
+func (c *cloner) copy(kind manifest.Kind, spec manifest.Spec, edit func(manifest.Spec)) error {
+	state, err := c.sourceReader.Live(manifest.Manifest{Kind: kind, Spec: spec})
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return fmt.Errorf("the %s %s does not exist", kind, spec.Key())
+	}
+	if edit != nil {
+		edit(state.Spec)
+	}
+	return c.apply(kind, state.Spec)
+}
+
+func (c *cloner) apply(kind manifest.Kind, spec manifest.Spec) error {
+	result, err := apply.Apply(c.targetApi, manifest.Manifest{Kind: kind, Spec: spec})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(formatter.StatusOutput(), "%s %s %s\n", kind, formatter.Colorize(spec.Key(), formatter.GREEN_COLOR), result)
+	return nil
+}
+
+// report records that what could not be copied because of err, nil when it was
+func (c *cloner) report(what string, err error) {
+	if err != nil {
+		logrus.Debugf("Could not copy the %s: %s", what, err)
+		c.notCopied = append(c.notCopied, fmt.Sprintf("%s: %s", what, strings.TrimSpace(err.Error())))
+	}
+}
+
+// wait waits for the task of a step, the next steps need the cluster to be active
+func (c *cloner) wait(clusterID string, taskType ybmclient.TaskTypeEnum, msg string) error {
+	returnStatus, err := c.targetApi.WaitForTaskCompletion(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, taskType, []string{"FAILED", "SUCCEEDED"}, msg)
+	if err != nil {
+		return fmt.Errorf("error when getting task status: %s", err)
+	}
+	if returnStatus != "SUCCEEDED" {
+		return fmt.Errorf("Operation failed with error: %s", returnStatus)
+	}
+	return nil
+}
+
+func init() {
+	ClusterCmd.AddCommand(cloneClusterCmd)
+	cloneClusterCmd.Flags().SortFlags = false
+	cloneClusterCmd.Flags().String("source", "", "[REQUIRED] Name or ID of the cluster to clone.")
+	cloneClusterCmd.MarkFlagRequired("source")
+	cloneClusterCmd.Flags().String("name", "", "[REQUIRED] Name of the new cluster.")
+	cloneClusterCmd.MarkFlagRequired("name")
+	cloneClusterCmd.Flags().StringToString("credentials", nil, `[REQUIRED] Credentials to login to the new cluster. Please provide key value pairs username=<user-name>,password=<password>.`)
+	cloneClusterCmd.MarkFlagRequired("credentials")
+	cloneClusterCmd.Flags().String("project-id", "", "[OPTIONAL] ID of the project to create the new cluster in. Default to the project of the source.")
+	cloneClusterCmd.Flags().String("profile", "", "[OPTIONAL] ybm-cli config file with the API key and host of the account to create the new cluster in. Default to the account of the source.")
+}
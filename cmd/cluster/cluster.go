@@ -29,6 +29,7 @@ import (
 	pitrconfig "github.com/yugabyte/ybm-cli/cmd/cluster/pitr-config"
 	readreplica "github.com/yugabyte/ybm-cli/cmd/cluster/read-replica"
 	"github.com/yugabyte/ybm-cli/cmd/util"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 )
 
 // getCmd represents the list command
@@ -47,38 +48,47 @@ func init() {
 	ClusterCmd.AddCommand(log_exporter.DbQueryLoggingCmd)
 	log_exporter.DbQueryLoggingCmd.PersistentFlags().StringVarP(&log_exporter.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	log_exporter.DbQueryLoggingCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(log_exporter.DbQueryLoggingCmd, log_exporter.DbQueryLoggingCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(audit_log_exporter.DbAuditLoggingCmd)
 	audit_log_exporter.DbAuditLoggingCmd.PersistentFlags().StringVarP(&audit_log_exporter.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	audit_log_exporter.DbAuditLoggingCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(audit_log_exporter.DbAuditLoggingCmd, audit_log_exporter.DbAuditLoggingCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(network.NetworkCmd)
 	network.NetworkCmd.PersistentFlags().StringVarP(&network.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	network.NetworkCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(network.NetworkCmd, network.NetworkCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(readreplica.ReadReplicaCmd)
 	readreplica.ReadReplicaCmd.PersistentFlags().StringVarP(&readreplica.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	readreplica.ReadReplicaCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(readreplica.ReadReplicaCmd, readreplica.ReadReplicaCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(node.NodeCmd)
 	node.NodeCmd.PersistentFlags().StringP("cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	node.NodeCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(node.NodeCmd, node.NodeCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(encryption.EncryptionCmd)
 	encryption.EncryptionCmd.PersistentFlags().StringP("cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	encryption.EncryptionCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(encryption.EncryptionCmd, encryption.EncryptionCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(namespace.NamespaceCmd)
 	namespace.NamespaceCmd.PersistentFlags().StringP("cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	namespace.NamespaceCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(namespace.NamespaceCmd, namespace.NamespaceCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(pitrconfig.PitrConfigCmd)
 	pitrconfig.PitrConfigCmd.PersistentFlags().StringVarP(&pitrconfig.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	pitrconfig.PitrConfigCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(pitrconfig.PitrConfigCmd, pitrconfig.PitrConfigCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	ClusterCmd.AddCommand(connectionpooling.ConnectionPoolingCmd)
 	connectionpooling.ConnectionPoolingCmd.PersistentFlags().StringVarP(&connectionpooling.ClusterName, "cluster-name", "c", "", "[REQUIRED] The name of the cluster.")
 	connectionpooling.ConnectionPoolingCmd.MarkPersistentFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(connectionpooling.ConnectionPoolingCmd, connectionpooling.ConnectionPoolingCmd.PersistentFlags(), "cluster-name", "cluster-id", "cluster")
 
 	util.AddCommandIfFeatureFlag(ClusterCmd, backupreplication.BackupReplicationCmd, util.BACKUP_REPLICATION_GCP_TARGET)
 }
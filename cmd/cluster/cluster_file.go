@@ -0,0 +1,201 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cluster
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yugabyte/ybm-cli/cmd/util"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+// clusterFile is the spec of a cluster read by --from-file. It is the spec of
+// a Cluster manifest, with the settings that only apply at creation.
+type clusterFile struct {
+	manifest.ClusterSpec    `yaml:",inline"`
+	PreferredRegion         string `yaml:"preferred-region,omitempty"`
+	DefaultRegion           string `yaml:"default-region,omitempty"`
+	EncryptionSpec          string `yaml:"encryption-spec,omitempty"`
+	EnableConnectionPooling bool   `yaml:"enable-connection-pooling,omitempty"`
+}
+
+// readClusterFile reads a yaml or json cluster spec, either bare or as the spec
+// of a Cluster manifest
+func readClusterFile(path string) (*clusterFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Kind manifest.Kind `yaml:"kind"`
+		Spec yaml.Node     `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("%s is not a cluster spec: %w", path, err)
+	}
+	if wrapper.Kind != "" {
+		if wrapper.Kind != manifest.KindCluster {
+			return nil, fmt.Errorf("%s is a %s manifest, not a %s", path, wrapper.Kind, manifest.KindCluster)
+		}
+		if data, err = yaml.Marshal(&wrapper.Spec); err != nil {
+			return nil, err
+		}
+	}
+	// Reject unknown fields, they are most likely typos
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	file := &clusterFile{}
+	if err := decoder.Decode(file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s is not a cluster spec: %w", path, err)
+	}
+	return file, nil
+}
+
+// flagValues returns the values of the file by the name of the flag they set
+func (f *clusterFile) flagValues() map[string][]string {
+	values := map[string][]string{}
+	set := func(flag string, value string) {
+		if value != "" {
+			values[flag] = append(values[flag], value)
+		}
+	}
+	set("cluster-name", f.Name)
+	set("cloud-provider", f.CloudProvider)
+	set("cluster-tier", f.ClusterTier)
+	set("cluster-type", f.ClusterType)
+	set("fault-tolerance", f.FaultTolerance)
+	if f.NumFaultsToTolerate != nil {
+		set("num-faults-to-tolerate", strconv.Itoa(int(*f.NumFaultsToTolerate)))
+	}
+	set("database-version", f.DatabaseVersion)
+	for _, region := range f.Regions {
+		set("region-info", regionInfoString(region))
+	}
+	set("preferred-region", f.PreferredRegion)
+	set("default-region", f.DefaultRegion)
+	set("encryption-spec", f.EncryptionSpec)
+	if f.EnableConnectionPooling {
+		set("enable-connection-pooling", "true")
+	}
+	set("network-allow-lists", strings.Join(f.NetworkAllowLists, ","))
+	return values
+}
+
+// applyClusterFile sets the flags of cmd that are not given on the command line
+// from the file at path, so that the flags override the file. It returns the
+// file and the fields cmd has no flag for.
+func applyClusterFile(cmd *cobra.Command, path string) (*clusterFile, []string, error) {
+	file, err := readClusterFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := file.flagValues()
+	flags := make([]string, 0, len(values))
+	for flag := range values {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+
+	ignored := []string{}
+	for _, flag := range flags {
+		f := cmd.Flags().Lookup(flag)
+		if f == nil {
+			ignored = append(ignored, flag)
+			continue
+		}
+		if f.Changed {
+			continue
+		}
+		for _, value := range values[flag] {
+			if err := cmd.Flags().Set(flag, value); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s in %s: %w", flag, path, err)
+			}
+		}
+	}
+	return file, ignored, nil
+}
+
+// regionInfoString returns the --region-info value of a region
+func regionInfoString(region manifest.RegionSpec) string {
+	regionInfo := fmt.Sprintf("region=%s,num-nodes=%d", region.Region, region.NumNodes)
+	if region.Vpc != "" {
+		regionInfo += ",vpc=" + region.Vpc
+	}
+	if region.NumCores != 0 {
+		regionInfo += fmt.Sprintf(",num-cores=%d", region.NumCores)
+	}
+	if region.DiskSizeGb != 0 {
+		regionInfo += fmt.Sprintf(",disk-size-gb=%d", region.DiskSizeGb)
+	}
+	if region.DiskIops != 0 {
+		regionInfo += fmt.Sprintf(",disk-iops=%d", region.DiskIops)
+	}
+	return regionInfo
+}
+
+// parseRegionInfo parses the key=value pairs of the --region-info flags of cmd
+func parseRegionInfo(cmd *cobra.Command) ([]map[string]string, error) {
+	regionInfoMapList := []map[string]string{}
+	if !cmd.Flags().Changed("region-info") {
+		return regionInfoMapList, nil
+	}
+	keys := []string{"region", "num-nodes", "vpc", "num-cores", "disk-size-gb", "disk-iops"}
+	if util.IsFeatureFlagEnabled(util.BACKUP_REPLICATION_GCP_TARGET) {
+		keys = append(keys, "backup-replication-gcp-target")
+	}
+
+	regionInfoList, _ := cmd.Flags().GetStringArray("region-info")
+	for _, regionInfoString := range regionInfoList {
+		regionInfoMap := map[string]string{}
+		for _, regionInfo := range strings.Split(regionInfoString, ",") {
+			kvp := strings.Split(regionInfo, "=")
+			if len(kvp) != 2 {
+				return nil, fmt.Errorf("Incorrect format in region info")
+			}
+			key := kvp[0]
+			val := kvp[1]
+			if len(strings.TrimSpace(val)) != 0 && slices.Contains(keys, key) {
+				regionInfoMap[key] = val
+			}
+		}
+
+		if _, ok := regionInfoMap["region"]; !ok {
+			return nil, fmt.Errorf("Region not specified in region info")
+		}
+		if _, ok := regionInfoMap["num-nodes"]; !ok {
+			return nil, fmt.Errorf("Number of nodes not specified in region info")
+		}
+		if _, ok := regionInfoMap["num-cores"]; !ok {
+			return nil, fmt.Errorf("Number of cores not specified in region info")
+		}
+		if _, ok := regionInfoMap["disk-size-gb"]; !ok {
+			return nil, fmt.Errorf("Disk size not specified in region info")
+		}
+
+		regionInfoMapList = append(regionInfoMapList, regionInfoMap)
+	}
+	return regionInfoMapList, nil
+}
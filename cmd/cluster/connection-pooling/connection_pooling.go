@@ -95,9 +95,9 @@ func performConnectionPoolingOperation(operationName string, cmd *cobra.Command,
 		if returnStatus != "SUCCEEDED" {
 			logrus.Fatalf("Operation failed with error: %s", returnStatus)
 		}
-		fmt.Printf("Connection Pooling has been %sd on cluster %s\n", operationName, formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Connection Pooling has been %sd on cluster %s\n", operationName, formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 	} else {
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	}
 }
 
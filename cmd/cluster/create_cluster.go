@@ -16,9 +16,9 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 
 	"encoding/base64"
 
@@ -40,7 +40,16 @@ func encodeBase64(s string) string {
 var createClusterCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a cluster",
-	Long:  "Create a cluster",
+	Long: `Create a cluster.
+
+The cluster can be described in a yaml or json file with --from-file, either
+the spec of a Cluster manifest such as the ones written by ybm export, or the
+manifest itself. Besides the fields of the manifest, the file accepts
+preferred-region, default-region, encryption-spec (in the format of the
+--encryption-spec flag) and enable-connection-pooling. The flags given on the
+command line override the values of the file.`,
+	Example: `ybm cluster create --from-file cluster.yaml --credentials username=admin,password=<password>
+ybm cluster create --from-file cluster.yaml --cluster-name copy --region-info region=us-west-2,num-nodes=3,num-cores=4,disk-size-gb=100`,
 	Run: func(cmd *cobra.Command, args []string) {
 		authApi, err := ybmAuthClient.NewAuthApiClient()
 		if err != nil {
@@ -48,68 +57,37 @@ var createClusterCmd = &cobra.Command{
 		}
 		authApi.GetInfo("", "")
 
-		clusterName, _ := cmd.Flags().GetString("cluster-name")
 		credentials, _ := cmd.Flags().GetStringToString("credentials")
+		if cmd.Flags().Changed("from-file") {
+			path, _ := cmd.Flags().GetString("from-file")
+			file, ignored, err := applyClusterFile(cmd, path)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			for _, field := range ignored {
+				logrus.Warnf("%s in %s is not supported by cluster create and is ignored", field, path)
+			}
+			if !cmd.Flags().Changed("credentials") && file.Credentials != nil {
+				credentials = map[string]string{"username": file.Credentials.Username, "password": file.Credentials.Password}
+			}
+		}
 
+		clusterName, _ := cmd.Flags().GetString("cluster-name")
+		if clusterName == "" {
+			logrus.Fatalln("The cluster name must be provided with --cluster-name or in --from-file")
+		}
 		username := credentials["username"]
 		password := credentials["password"]
-		regionInfoMapList := []map[string]string{}
-		changedRegionInfo := cmd.Flags().Changed("region-info")
-
-		if changedRegionInfo {
-			regionInfoList, _ := cmd.Flags().GetStringArray("region-info")
-			for _, regionInfoString := range regionInfoList {
-				regionInfoMap := map[string]string{}
-				for _, regionInfo := range strings.Split(regionInfoString, ",") {
-					kvp := strings.Split(regionInfo, "=")
-					if len(kvp) != 2 {
-						logrus.Fatalln("Incorrect format in region info")
-					}
-					key := kvp[0]
-					val := kvp[1]
-					switch key {
-					case "region":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["region"] = val
-						}
-					case "num-nodes":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["num-nodes"] = val
-						}
-					case "vpc":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["vpc"] = val
-						}
-					case "num-cores":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["num-cores"] = val
-						}
-					case "disk-size-gb":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["disk-size-gb"] = val
-						}
-					case "disk-iops":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["disk-iops"] = val
-						}
-					}
-				}
-
-				if _, ok := regionInfoMap["region"]; !ok {
-					logrus.Fatalln("Region not specified in region info")
-				}
-				if _, ok := regionInfoMap["num-nodes"]; !ok {
-					logrus.Fatalln("Number of nodes not specified in region info")
-				}
-				if _, ok := regionInfoMap["num-cores"]; !ok {
-					logrus.Fatalln("Number of cores not specified in region info")
-				}
-				if _, ok := regionInfoMap["disk-size-gb"]; !ok {
-					logrus.Fatalln("Disk size not specified in region info")
-				}
-
-				regionInfoMapList = append(regionInfoMapList, regionInfoMap)
-			}
+		if username == "" || password == "" {
+			logrus.Fatalln("The credentials must be provided with --credentials or in --from-file")
+		}
+
+		regionInfoMapList, err := parseRegionInfo(cmd)
+		if err != nil {
+			logrus.Fatalln(err)
+		}
+		if len(regionInfoMapList) == 0 {
+			logrus.Fatalln("The region info must be provided with --region-info or in --from-file")
 		}
 
 		cmkSpec, err := encryption.GetCmkSpecFromCommand(cmd)
@@ -143,8 +121,10 @@ var createClusterCmd = &cobra.Command{
 			createClusterRequest.SecurityCmkSpec = *ybmclient.NewNullableCMKSpec(cmkSpec)
 		}
 
-		// Debug log the complete request payload
-		logrus.Debugf("Create Cluster Request Payload: %+v", *createClusterRequest)
+		// Debug log the complete request payload, credentials are masked by the log formatter
+		if payload, err := json.Marshal(createClusterRequest); err == nil {
+			logrus.Debugf("Create Cluster Request Payload: %s", payload)
+		}
 		if createClusterRequest.Features != nil {
 			logrus.Debugf("Features in final request: %v", *createClusterRequest.Features)
 		}
@@ -168,7 +148,7 @@ var createClusterCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The cluster %s has been created\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been created\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 			respC, r, err := authApi.ListClusters().Name(clusterName).Execute()
 			if err != nil {
@@ -177,7 +157,7 @@ var createClusterCmd = &cobra.Command{
 			}
 			clusterData = respC.GetData()
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		clustersCtx := formatter.Context{
@@ -201,10 +181,8 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	createClusterCmd.Flags().SortFlags = false
-	createClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster.")
-	createClusterCmd.MarkFlagRequired("cluster-name")
-	createClusterCmd.Flags().StringToString("credentials", nil, `[REQUIRED] Credentials to login to the cluster. Please provide key value pairs username=<user-name>,password=<password>.`)
-	createClusterCmd.MarkFlagRequired("credentials")
+	createClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster, unless given in --from-file.")
+	createClusterCmd.Flags().StringToString("credentials", nil, `[REQUIRED] Credentials to login to the cluster, unless given in --from-file. Please provide key value pairs username=<user-name>,password=<password>.`)
 	createClusterCmd.Flags().String("cloud-provider", "", "[OPTIONAL] The cloud provider where database needs to be deployed. AWS, AZURE or GCP. Default AWS.")
 	createClusterCmd.Flags().String("cluster-tier", "", "[OPTIONAL] The tier of the cluster. Sandbox or Dedicated. Default Sandbox.")
 	createClusterCmd.Flags().String("cluster-type", "", "[OPTIONAL] Cluster replication type. SYNCHRONOUS or GEO_PARTITIONED. Default SYNCHRONOUS.")
@@ -224,8 +202,11 @@ func init() {
 	If specified, all parameters for that provider are mandatory.`)
 	createClusterCmd.Flags().String("fault-tolerance", "", "[OPTIONAL] Fault tolerance of the cluster. The possible values are NONE, NODE, ZONE, or REGION. Default NONE.")
 	createClusterCmd.Flags().Int32("num-faults-to-tolerate", 0, "[OPTIONAL] The number of domain faults to tolerate for the level specified. The possible values are 0 for NONE, 1 for ZONE and [1-3] for anything else. Defaults to 0 for NONE, 1 otherwise.")
-	createClusterCmd.Flags().StringArray("region-info", []string{}, `Region information for the cluster, provided as key-value pairs. Arguments are region=<region-name>,num-nodes=<number-of-nodes>,vpc=<vpc-name>,num-cores=<num-cores>,disk-size-gb=<disk-size-gb>,disk-iops=<disk-iops> (AWS only). region, num-nodes, num-cores, disk-size-gb are required. Specify one --region-info flag for each region in the cluster.`)
-	createClusterCmd.MarkFlagRequired("region-info")
+	createClusterCmd.Flags().StringArray("region-info", []string{}, `Region information for the cluster, provided as key-value pairs, unless given in --from-file. Arguments are region=<region-name>,num-nodes=<number-of-nodes>,vpc=<vpc-name>,num-cores=<num-cores>,disk-size-gb=<disk-size-gb>,disk-iops=<disk-iops> (AWS only). region, num-nodes, num-cores, disk-size-gb are required. Specify one --region-info flag for each region in the cluster.`)
+	createClusterCmd.Flags().String("from-file", "", "[OPTIONAL] YAML or JSON file of the cluster spec. The flags given on the command line override its values.")
+	createClusterCmd.MarkFlagsOneRequired("cluster-name", "from-file")
+	createClusterCmd.MarkFlagsOneRequired("credentials", "from-file")
+	createClusterCmd.MarkFlagsOneRequired("region-info", "from-file")
 	createClusterCmd.Flags().String("preferred-region", "", "[OPTIONAL] The preferred region in a multi region cluster. The preferred region handles all read and write requests from clients.")
 	createClusterCmd.Flags().String("default-region", "", "[OPTIONAL] The primary region in a partition-by-region cluster. The primary region is where all the tables not created in a tablespace reside.")
 	createClusterCmd.Flags().Bool("enable-connection-pooling", false, "[OPTIONAL] Enable connection pooling for the cluster during creation. Default false.")
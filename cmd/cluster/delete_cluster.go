@@ -68,10 +68,10 @@ var deleteClusterCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The cluster %s has been deleted\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been deleted\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 			return
 		}
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	},
 }
 
@@ -88,5 +88,6 @@ func init() {
 	// is called directly, e.g.:
 	deleteClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster to be deleted.")
 	deleteClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(deleteClusterCmd, deleteClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	deleteClusterCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
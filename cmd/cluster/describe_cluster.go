@@ -16,6 +16,7 @@
 package cluster
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/spf13/viper"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	"github.com/yugabyte/ybm-cli/internal/formatter"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
 var describeClusterCmd = &cobra.Command{
@@ -36,25 +38,22 @@ var describeClusterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 		authApi.GetInfo("", "")
-		clusterListRequest := authApi.ListClusters()
 		clusterName, _ := cmd.Flags().GetString("cluster-name")
-		clusterListRequest = clusterListRequest.Name(clusterName)
-
-		resp, r, err := clusterListRequest.Execute()
 
+		clusterData, err := authApi.GetClusterByName(clusterName)
+		var notFound *ybmAuthClient.NotFoundError
+		if errors.As(err, &notFound) {
+			fmt.Fprintln(formatter.StatusOutput(), "No cluster found"+notFound.DidYouMean())
+			return
+		}
 		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
-		if len(resp.GetData()) < 1 {
-			fmt.Println("No cluster found")
-			return
-		}
-		if len(resp.GetData()) > 0 && viper.GetString("output") == "table" {
+		if viper.GetString("output") == "table" {
 			fullClusterContext := *formatter.NewFullClusterContext()
 			fullClusterContext.Output = os.Stdout
 			fullClusterContext.Format = formatter.NewFullClusterFormat(viper.GetString("output"))
-			fullClusterContext.SetFullCluster(*authApi, resp.GetData()[0])
+			fullClusterContext.SetFullCluster(*authApi, clusterData)
 			fullClusterContext.Write()
 			return
 		}
@@ -63,7 +62,7 @@ var describeClusterCmd = &cobra.Command{
 			Output: os.Stdout,
 			Format: formatter.NewClusterFormat(viper.GetString("output")),
 		}
-		formatter.ClusterWrite(clustersCtx, resp.GetData())
+		formatter.ClusterWrite(clustersCtx, []ybmclient.ClusterData{clusterData})
 	},
 }
 
@@ -71,4 +70,5 @@ func init() {
 	ClusterCmd.AddCommand(describeClusterCmd)
 	describeClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster to get details.")
 	describeClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(describeClusterCmd, describeClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 }
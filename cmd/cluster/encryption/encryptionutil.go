@@ -131,7 +131,7 @@ func GetCmkSpecFromCommand(cmd *cobra.Command) (*ybmclient.CMKSpec, error) {
 					cmkAwsSecretKey = value
 				} else {
 					// If not found, prompt the user.
-					fmt.Print("Please provide the AWS Secret Key for Encryption at Rest: ")
+					fmt.Fprint(os.Stderr, "Please provide the AWS Secret Key for Encryption at Rest: ")
 
 					data, err := term.ReadPassword(int(os.Stdin.Fd()))
 					if err != nil {
@@ -185,7 +185,7 @@ func GetCmkSpecFromCommand(cmd *cobra.Command) (*ybmclient.CMKSpec, error) {
 					cmkAzureClientSecret = value
 				} else {
 					// If not found, prompt the user.
-					fmt.Print("Please provide the AZURE Secret Key for Encryption at Rest: ")
+					fmt.Fprint(os.Stderr, "Please provide the AZURE Secret Key for Encryption at Rest: ")
 
 					data, err := term.ReadPassword(int(os.Stdin.Fd()))
 					if err != nil {
@@ -17,13 +17,15 @@ package cluster
 
 import (
 	"fmt"
-	"os"
+	"io"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/cluster"
 	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/watch"
 )
 
 var listClusterCmd = &cobra.Command{
@@ -36,32 +38,51 @@ var listClusterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 		authApi.GetInfo("", "")
-		clusterListRequest := authApi.ListClusters()
-		// if user filters by name, add it to the request
-		clusterName, _ := cmd.Flags().GetString("cluster-name")
-		if clusterName != "" {
-			clusterListRequest = clusterListRequest.Name(clusterName)
+		err = watch.Run(cmd, args, func(out io.Writer) error {
+			return listClusters(authApi, cmd, out)
+		})
+		if err != nil {
+			logrus.Fatal(err)
 		}
+	},
+}
 
-		resp, r, err := clusterListRequest.Execute()
+func listClusters(authApi *ybmAuthClient.AuthApiClient, cmd *cobra.Command, out io.Writer) error {
+	clusterListRequest := authApi.ListClusters()
+	// if user filters by name, add it to the request
+	clusterName, _ := cmd.Flags().GetString("cluster-name")
+	if clusterName != "" {
+		clusterListRequest = clusterListRequest.Name(clusterName)
+	}
 
-		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
+	clusters, r, err := authApi.ListClustersPaged(clusterListRequest, ybmAuthClient.GetPageOptions(cmd))
 
-		clustersCtx := formatter.Context{
-			Output: os.Stdout,
-			Format: formatter.NewClusterFormat(viper.GetString("output")),
-		}
-		if len(resp.GetData()) < 1 {
-			fmt.Println("No clusters found")
-			return
-		}
-		formatter.ClusterWrite(clustersCtx, resp.GetData())
-	},
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+	}
+
+	clustersCtx := formatter.Context{
+		Output: out,
+		Format: formatter.NewClusterFormat(viper.GetString("output")),
+	}
+	if len(clusters) < 1 {
+		fmt.Fprintln(formatter.StatusOutput(), "No clusters found")
+		return nil
+	}
+	if details, _ := cmd.Flags().GetBool("details"); details {
+		parallelism, _ := cmd.Flags().GetInt("parallelism")
+		fullClusters := cluster.NewFullClusters(*authApi, clusters, parallelism)
+		clustersCtx.Format = formatter.NewFullClusterFormat(viper.GetString("output"))
+		return formatter.FullClusterListWrite(clustersCtx, fullClusters)
+	}
+	return formatter.ClusterWrite(clustersCtx, clusters)
 }
 
 func init() {
 	ClusterCmd.AddCommand(listClusterCmd)
+	ybmAuthClient.AddPaginationFlags(listClusterCmd)
+	watch.AddFlag(listClusterCmd)
+	listClusterCmd.Flags().Bool("details", false, "[OPTIONAL] Show the full view of every cluster: regions, endpoints, allow lists, VPCs, encryption and nodes.")
+	listClusterCmd.Flags().Int("parallelism", cluster.DefaultParallelism, "[OPTIONAL] Number of clusters fetched at the same time with --details.")
 }
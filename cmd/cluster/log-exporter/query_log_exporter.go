@@ -78,7 +78,7 @@ var enableDbQueryLoggingCmd = &cobra.Command{
 		msg := fmt.Sprintf("DB query logging is being enabled for cluster %s", clusterName)
 		if viper.GetBool("wait") {
 			waitForDbLoggingTaskCompletion(clusterId, ybmclient.TASKTYPEENUM_ENABLE_DATABASE_QUERY_LOGGING, msg, authApi)
-			fmt.Printf("DB query logging has been enabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB query logging has been enabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 			dqlConfig = *getDbLoggingConfig(clusterId, authApi)
 		}
 
@@ -158,9 +158,9 @@ var disableLogExporterCmd = &cobra.Command{
 		msg := fmt.Sprintf("DB query logging is being disabled for cluster %s", clusterName)
 		if viper.GetBool("wait") {
 			waitForDbLoggingTaskCompletion(clusterId, ybmclient.TASKTYPEENUM_DISABLE_DATABASE_QUERY_LOGGING, msg, authApi)
-			fmt.Printf("DB query logging has been disabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB query logging has been disabled for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 		} else {
-			fmt.Printf(`Request submitted to disable DB query logging for the cluster, this may take a few minutes...
+			fmt.Fprintf(formatter.StatusOutput(), `Request submitted to disable DB query logging for the cluster, this may take a few minutes...
 You can check the status via $ ybm cluster db-query-logging describe --cluster-name %s%s`, formatter.Colorize(clusterName, formatter.GREEN_COLOR), "\n")
 		}
 	},
@@ -225,11 +225,11 @@ var updateLogExporterConfigCmd = &cobra.Command{
 		msg := fmt.Sprintf("The db query logging configuration is being updated for cluster %s", clusterName)
 		if viper.GetBool("wait") {
 			waitForDbLoggingTaskCompletion(clusterId, ybmclient.TASKTYPEENUM_EDIT_DATABASE_QUERY_LOGGING, msg, authApi)
-			fmt.Printf("DB query logging configuration has been updated for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DB query logging configuration has been updated for the cluster %v\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 			dqlConfig = *getDbLoggingConfig(clusterId, authApi)
 		} else {
-			fmt.Println("Request submitted to edit DB query log config for the cluster, this may take a few minutes...")
+			fmt.Fprintln(formatter.StatusOutput(), "Request submitted to edit DB query log config for the cluster, this may take a few minutes...")
 		}
 
 		formatter.DbQueryLoggingWriteFull(dqlConfig, integrationName)
@@ -266,6 +266,7 @@ func init() {
 	enableDbQueryLoggingCmd.Flags().SortFlags = false
 	enableDbQueryLoggingCmd.Flags().String("integration-name", "", "[REQUIRED] Name of the Integration")
 	enableDbQueryLoggingCmd.MarkFlagRequired("integration-name")
+	ybmAuthClient.AddIdFlag(enableDbQueryLoggingCmd, enableDbQueryLoggingCmd.Flags(), "integration-name", "integration-id", "integration")
 	enableDbQueryLoggingCmd.Flags().String("debug-print-plan", "false", "[OPTIONAL] Enables various debugging output to be emitted.")
 	enableDbQueryLoggingCmd.Flags().Int32("log-min-duration-statement", -1, "[OPTIONAL] Duration(in ms) of each completed statement to be logged if the statement ran for at least the specified amount of time. Default -1 (log all statements).")
 	enableDbQueryLoggingCmd.Flags().String("log-connections", "false", "[OPTIONAL] Log connection attempts.")
@@ -278,6 +279,7 @@ func init() {
 
 	DbQueryLoggingCmd.AddCommand(updateLogExporterConfigCmd)
 	updateLogExporterConfigCmd.Flags().String("integration-name", "", "[OPTIONAL] Name of the Integration")
+	ybmAuthClient.AddIdFlag(updateLogExporterConfigCmd, updateLogExporterConfigCmd.Flags(), "integration-name", "integration-id", "integration")
 	updateLogExporterConfigCmd.Flags().String("debug-print-plan", "", "[OPTIONAL] Enables various debugging output to be emitted.")
 	updateLogExporterConfigCmd.Flags().Int32("log-min-duration-statement", -1, "[OPTIONAL] Duration(in ms) of each completed statement to be logged if the statement ran for at least the specified amount of time.")
 	updateLogExporterConfigCmd.Flags().String("log-connections", "", "[OPTIONAL] Log connection attempts.")
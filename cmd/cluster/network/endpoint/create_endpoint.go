@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yugabyte/ybm-cli/cmd/util"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -92,7 +93,7 @@ var createEndpointCmd = &cobra.Command{
 			}
 
 			msg := fmt.Sprintf("Created private service endpoint in region %v\n", reg)
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		default:
 			logrus.Fatalf("Endpoint is not a private service endpoint. Only private service endpoints are currently supported.\n")
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/yugabyte/ybm-cli/cmd/util"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -66,7 +67,7 @@ var deleteEndpointCmd = &cobra.Command{
 			}
 
 			msg := fmt.Sprintf("Deleted endpoint %s", endpointId)
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		default:
 			logrus.Fatalf("Endpoint is not a private service endpoint. Only private service endpoints are currently supported.\n")
@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yugabyte/ybm-cli/cmd/util"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -74,7 +75,7 @@ var updateEndpointCmd = &cobra.Command{
 			}
 
 			msg := fmt.Sprintf("Updated endpoint %s", updateResp.Data.Info.Id)
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		default:
 			logrus.Fatalf("Endpoint is not a private service endpoint. Only private service endpoints are currently supported.\n")
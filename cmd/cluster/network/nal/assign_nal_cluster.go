@@ -76,10 +76,10 @@ var assignClusterCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The network allow list %s has been assigned to the cluster %s\n", formatter.Colorize(newNetworkAllowListName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The network allow list %s has been assigned to the cluster %s\n", formatter.Colorize(newNetworkAllowListName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -88,4 +88,5 @@ func init() {
 	AllowListCmd.AddCommand(assignClusterCmd)
 	assignClusterCmd.Flags().String("network-allow-list", "", "[REQUIRED] The name of the network allow list to be assigned.")
 	assignClusterCmd.MarkFlagRequired("network-allow-list")
+	ybmAuthClient.AddIdFlag(assignClusterCmd, assignClusterCmd.Flags(), "network-allow-list", "network-allow-list-id", "network allow list")
 }
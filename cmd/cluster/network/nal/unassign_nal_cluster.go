@@ -84,10 +84,10 @@ var unassignClusterCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The network allow list %s has been unassigned from the cluster %s\n", formatter.Colorize(newNetworkAllowListName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The network allow list %s has been unassigned from the cluster %s\n", formatter.Colorize(newNetworkAllowListName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -97,4 +97,5 @@ func init() {
 	AllowListCmd.AddCommand(unassignClusterCmd)
 	unassignClusterCmd.Flags().String("network-allow-list", "", "[REQUIRED] The name of the network allow list to be unassigned.")
 	unassignClusterCmd.MarkFlagRequired("network-allow-list")
+	ybmAuthClient.AddIdFlag(unassignClusterCmd, unassignClusterCmd.Flags(), "network-allow-list", "network-allow-list-id", "network allow list")
 }
@@ -16,13 +16,15 @@
 package node
 
 import (
-	"os"
+	"fmt"
+	"io"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/watch"
 )
 
 var listNodeCmd = &cobra.Command{
@@ -42,25 +44,30 @@ var listNodeCmd = &cobra.Command{
 			logrus.Fatalf("%s", ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		resp, r, err := authApi.GetClusterNode(clusterId).Execute()
-		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
+		err = watch.Run(cmd, args, func(out io.Writer) error {
+			resp, r, err := authApi.GetClusterNode(clusterId).Execute()
+			if err != nil {
+				logrus.Debugf("Full HTTP response: %v", r)
+				return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+			}
 
-		if len(resp.GetData()) == 0 {
-			logrus.Fatalf("No nodes found")
-		}
+			if len(resp.GetData()) == 0 {
+				return fmt.Errorf("No nodes found")
+			}
 
-		nodesCtx := formatter.Context{
-			Output: os.Stdout,
-			Format: formatter.NewNodeFormat(viper.GetString("output")),
+			nodesCtx := formatter.Context{
+				Output: out,
+				Format: formatter.NewNodeFormat(viper.GetString("output")),
+			}
+			return formatter.NodeWrite(nodesCtx, resp.GetData())
+		})
+		if err != nil {
+			logrus.Fatal(err)
 		}
-		formatter.NodeWrite(nodesCtx, resp.GetData())
-
 	},
 }
 
 func init() {
 	NodeCmd.AddCommand(listNodeCmd)
+	watch.AddFlag(listNodeCmd)
 }
@@ -61,9 +61,9 @@ var stopNodeCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The node %s has been stopped\n", formatter.Colorize(nodeName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The node %s has been stopped\n", formatter.Colorize(nodeName, formatter.GREEN_COLOR))
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -103,9 +103,9 @@ var startNodeCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The node %s has been started\n", formatter.Colorize(nodeName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The node %s has been started\n", formatter.Colorize(nodeName, formatter.GREEN_COLOR))
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -115,12 +115,14 @@ func init() {
 	util.AddCommandIfFeatureFlag(NodeCmd, stopNodeCmd, util.NODE_OP)
 	stopNodeCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster to get details.")
 	stopNodeCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(stopNodeCmd, stopNodeCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	stopNodeCmd.Flags().String("node-name", "", "[REQUIRED] The name of the node to stop.")
 	stopNodeCmd.MarkFlagRequired("node-name")
 
 	util.AddCommandIfFeatureFlag(NodeCmd, startNodeCmd, util.NODE_OP)
 	startNodeCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster to get details.")
 	startNodeCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(startNodeCmd, startNodeCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	startNodeCmd.Flags().String("node-name", "", "[REQUIRED] The name of the node to stop.")
 	startNodeCmd.MarkFlagRequired("node-name")
 }
@@ -141,7 +141,7 @@ var createPitrConfigCmd = &cobra.Command{
 
 		if viper.GetBool("wait") {
 			handleTaskCompletion(authApi, clusterID, msg, ybmclient.TASKTYPEENUM_BULK_ENABLE_DB_PITR)
-			fmt.Printf("Successfully created PITR configurations.\n\n")
+			fmt.Fprintf(formatter.StatusOutput(), "Successfully created PITR configurations.\n\n")
 			createdConfigsData := []ybmclient.DatabasePitrConfigData{}
 			for _, configData := range pitrConfigsData {
 				configId := configData.Info.Id
@@ -160,7 +160,7 @@ var createPitrConfigCmd = &cobra.Command{
 
 			formatter.PitrConfigWrite(pitrConfigCtx, createdConfigsData)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -282,9 +282,9 @@ var restorePitrConfigCmd = &cobra.Command{
 
 		if viper.GetBool("wait") {
 			handleTaskCompletion(authApi, clusterID, msg, ybmclient.TASKTYPEENUM_RESTORE_DB_PITR)
-			fmt.Printf("\nSuccessfully restored %s namespace %s in cluster %s to the snapshot at %d ms.\n\n", namespaceType, namespaceName, ClusterName, restoreAtMilis)
+			fmt.Fprintf(formatter.StatusOutput(), "\nSuccessfully restored %s namespace %s in cluster %s to the snapshot at %d ms.\n\n", namespaceType, namespaceName, ClusterName, restoreAtMilis)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -329,9 +329,9 @@ var deletePitrConfigCmd = &cobra.Command{
 
 		if viper.GetBool("wait") {
 			handleTaskCompletion(authApi, clusterID, msg, ybmclient.TASKTYPEENUM_DISABLE_DB_PITR)
-			fmt.Printf("\nSuccessfully removed PITR Configuration for %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
+			fmt.Fprintf(formatter.StatusOutput(), "\nSuccessfully removed PITR Configuration for %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -383,9 +383,9 @@ var updatePitrConfigCmd = &cobra.Command{
 
 		if viper.GetBool("wait") {
 			handleTaskCompletion(authApi, clusterID, msg, ybmclient.TASKTYPEENUM_UPDATE_DB_PITR)
-			fmt.Printf("\nSuccessfully updated PITR Configuration for %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
+			fmt.Fprintf(formatter.StatusOutput(), "\nSuccessfully updated PITR Configuration for %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -444,9 +444,9 @@ var clonePitrConfigCmd = &cobra.Command{
 
 		if viper.GetBool("wait") {
 			handleTaskCompletion(authApi, clusterID, msg, ybmclient.TASKTYPEENUM_CLONE_DB_PITR)
-			fmt.Printf("\nSuccessfully cloned %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
+			fmt.Fprintf(formatter.StatusOutput(), "\nSuccessfully cloned %s namespace %s in cluster %s.\n\n", namespaceType, namespaceName, ClusterName)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 	},
 }
@@ -261,7 +261,7 @@ var createReadReplicaCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("Read Replica has been created for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "Read Replica has been created for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 
 			resp, r, err = authApi.ListReadReplicas(clusterID).Execute()
 			if err != nil {
@@ -269,7 +269,7 @@ var createReadReplicaCmd = &cobra.Command{
 				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 			}
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 		printReadReplicaOutput(resp)
 	},
@@ -324,7 +324,7 @@ var updateReadReplicaCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("Read Replica has been updated for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "Read Replica has been updated for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 
 			resp, r, err = authApi.ListReadReplicas(clusterID).Execute()
 			if err != nil {
@@ -332,7 +332,7 @@ var updateReadReplicaCmd = &cobra.Command{
 				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 			}
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 		printReadReplicaOutput(resp)
 	},
@@ -375,10 +375,10 @@ var deleteReadReplicaCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("All Read Replica has been deleted for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "All Read Replica has been deleted for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 			return
 		}
-		fmt.Printf("All Read Replica has been deleted for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "All Read Replica has been deleted for cluster %s.\n", formatter.Colorize(ClusterName, formatter.GREEN_COLOR))
 
 	},
 }
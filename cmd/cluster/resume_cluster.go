@@ -16,14 +16,10 @@
 package cluster
 
 import (
-	"fmt"
-	"os"
+	"net/http"
 
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
-	"github.com/yugabyte/ybm-cli/internal/formatter"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -33,52 +29,14 @@ var resumeClusterCmd = &cobra.Command{
 	Short: "Resume a cluster",
 	Long:  "Resume a cluster",
 	Run: func(cmd *cobra.Command, args []string) {
-		authApi, err := ybmAuthClient.NewAuthApiClient()
-		if err != nil {
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
-		authApi.GetInfo("", "")
-		clusterName, _ := cmd.Flags().GetString("cluster-name")
-		clusterID, err := authApi.GetClusterIdByName(clusterName)
-		if err != nil {
-			logrus.Fatal(err)
-		}
-		resp, r, err := authApi.ResumeCluster(clusterID).Execute()
-		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
-
-		clusterData := []ybmclient.ClusterData{resp.GetData()}
-
-		msg := fmt.Sprintf("The cluster %s is being resumed", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
-
-		if viper.GetBool("wait") {
-			returnStatus, err := authApi.WaitForTaskCompletion(clusterID, ybmclient.ENTITYTYPEENUM_CLUSTER, ybmclient.TASKTYPEENUM_RESUME_CLUSTER, []string{"FAILED", "SUCCEEDED"}, msg)
-			if err != nil {
-				logrus.Fatalf("error when getting task status: %s", err)
-			}
-			if returnStatus != "SUCCEEDED" {
-				logrus.Fatalf("Operation failed with error: %s", returnStatus)
-			}
-			fmt.Printf("The cluster %s has been resumed\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
-
-			respC, r, err := authApi.ListClusters().Name(clusterName).Execute()
-			if err != nil {
-				logrus.Debugf("Full HTTP response: %v", r)
-				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-			}
-			clusterData = respC.GetData()
-		} else {
-			fmt.Println(msg)
-		}
-
-		clustersCtx := formatter.Context{
-			Output: os.Stdout,
-			Format: formatter.NewClusterFormat(viper.GetString("output")),
-		}
-
-		formatter.ClusterWrite(clustersCtx, clusterData)
+		runClusterOperation(cmd, clusterOperation{
+			done:     "resumed",
+			taskType: ybmclient.TASKTYPEENUM_RESUME_CLUSTER,
+			start: func(authApi *ybmAuthClient.AuthApiClient, clusterID string) (ybmclient.ClusterData, *http.Response, error) {
+				resp, r, err := authApi.ResumeCluster(clusterID).Execute()
+				return resp.GetData(), r, err
+			},
+		})
 	},
 }
 
@@ -94,6 +52,7 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// resumeClusterCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
-	resumeClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster to be resumed.")
+	resumeClusterCmd.Flags().StringArray("cluster-name", []string{}, "[REQUIRED] The name of the cluster to be resumed. Repeat it to resume several clusters, which are waited for together.")
 	resumeClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(resumeClusterCmd, resumeClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 }
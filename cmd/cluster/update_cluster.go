@@ -34,7 +34,13 @@ import (
 var updateClusterCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update a cluster",
-	Long:  "Update a cluster",
+	Long: `Update a cluster.
+
+The new spec of the cluster can be read from a yaml or json file with
+--from-file, in the format of cluster create --from-file. A name in the file
+that differs from --cluster-name renames the cluster. The flags given on the
+command line override the values of the file, and the settings in neither
+are kept.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		clusterName, _ := cmd.Flags().GetString("cluster-name")
@@ -59,73 +65,38 @@ var updateClusterCmd = &cobra.Command{
 		if err != nil {
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
+		if cmd.Flags().Changed("from-file") {
+			path, _ := cmd.Flags().GetString("from-file")
+			file, err := readClusterFile(path)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			// A different name in the file renames the cluster
+			if file.Name != "" && file.Name != clusterName && file.Name != clusterID && !cmd.Flags().Changed("new-name") {
+				cmd.Flags().Set("new-name", file.Name)
+			}
+			_, ignored, err := applyClusterFile(cmd, path)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			for _, field := range ignored {
+				logrus.Warnf("%s in %s is not supported by cluster update and is ignored", field, path)
+			}
+			if file.Credentials != nil {
+				logrus.Warnf("credentials in %s are only used to create a cluster and are ignored", path)
+			}
+		}
 		isNameChange := isNameUpdateOnly(cmd)
 		populateFlags(cmd, originalSpec, trackName, authApi)
 
-		regionInfoMapList := []map[string]string{}
-		changedRegionInfo := cmd.Flags().Changed("region-info")
+		regionInfoMapList, err := parseRegionInfo(cmd)
+		if err != nil {
+			logrus.Fatalln(err)
+		}
 		cmdHasBackupReplication := false
-
-		if changedRegionInfo {
-			regionInfoList, _ := cmd.Flags().GetStringArray("region-info")
-			for _, regionInfoString := range regionInfoList {
-				regionInfoMap := map[string]string{}
-				for _, regionInfo := range strings.Split(regionInfoString, ",") {
-					kvp := strings.Split(regionInfo, "=")
-					if len(kvp) != 2 {
-						logrus.Fatalln("Incorrect format in region info")
-					}
-					key := kvp[0]
-					val := kvp[1]
-					switch key {
-					case "region":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["region"] = val
-						}
-					case "num-nodes":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["num-nodes"] = val
-						}
-					case "vpc":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["vpc"] = val
-						}
-					case "num-cores":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["num-cores"] = val
-						}
-					case "disk-size-gb":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["disk-size-gb"] = val
-						}
-					case "disk-iops":
-						if len(strings.TrimSpace(val)) != 0 {
-							regionInfoMap["disk-iops"] = val
-						}
-					case "backup-replication-gcp-target":
-						if util.IsFeatureFlagEnabled(util.BACKUP_REPLICATION_GCP_TARGET) {
-							if len(strings.TrimSpace(val)) != 0 {
-								regionInfoMap["backup-replication-gcp-target"] = val
-								cmdHasBackupReplication = true
-							}
-						}
-					}
-				}
-
-				if _, ok := regionInfoMap["region"]; !ok {
-					logrus.Fatalln("Region not specified in region info")
-				}
-				if _, ok := regionInfoMap["num-nodes"]; !ok {
-					logrus.Fatalln("Number of nodes not specified in region info")
-				}
-				if _, ok := regionInfoMap["num-cores"]; !ok {
-					logrus.Fatalln("Number of cores not specified in region info")
-				}
-				if _, ok := regionInfoMap["disk-size-gb"]; !ok {
-					logrus.Fatalln("Disk size not specified in region info")
-				}
-
-				regionInfoMapList = append(regionInfoMapList, regionInfoMap)
+		for _, regionInfoMap := range regionInfoMapList {
+			if _, ok := regionInfoMap["backup-replication-gcp-target"]; ok {
+				cmdHasBackupReplication = true
 			}
 		}
 
@@ -172,16 +143,16 @@ var updateClusterCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The cluster %s has been updated\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has been updated\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 
-			respC, r, err := authApi.ListClusters().Name(clusterName).Execute()
+			respC, r, err := authApi.GetCluster(clusterID).Execute()
 			if err != nil {
 				logrus.Debugf("Full HTTP response: %v", r)
 				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 			}
-			clusterData = respC.GetData()
+			clusterData = []ybmclient.ClusterData{respC.GetData()}
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		clustersCtx := formatter.Context{
@@ -207,6 +178,7 @@ func init() {
 	// is called directly, e.g.:
 	updateClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] Name of the cluster.")
 	updateClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(updateClusterCmd, updateClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	updateClusterCmd.Flags().String("new-name", "", "[OPTIONAL] The new name to be given to the cluster.")
 	updateClusterCmd.Flags().String("cloud-provider", "", "[OPTIONAL] The cloud provider where database needs to be deployed. AWS, AZURE or GCP.")
 	updateClusterCmd.Flags().String("cluster-type", "", "[OPTIONAL] Cluster replication type. SYNCHRONOUS or GEO_PARTITIONED.")
@@ -215,7 +187,8 @@ func init() {
 	} else {
 		updateClusterCmd.Flags().StringArray("region-info", []string{}, `Region information for the cluster, provided as key-value pairs. Arguments are region=<region-name>,num-nodes=<number-of-nodes>,vpc=<vpc-name>,num-cores=<num-cores>,disk-size-gb=<disk-size-gb>,disk-iops=<disk-iops> (AWS only). region, num-nodes, num-cores, disk-size-gb are required. Specify one --region-info flag for each region in the cluster.`)
 	}
-	updateClusterCmd.MarkFlagRequired("region-info")
+	updateClusterCmd.Flags().String("from-file", "", "[OPTIONAL] YAML or JSON file of the cluster spec, in the format of cluster create --from-file. The flags given on the command line override its values.")
+	updateClusterCmd.MarkFlagsOneRequired("region-info", "from-file")
 	updateClusterCmd.Flags().String("cluster-tier", "", "[OPTIONAL] The tier of the cluster. Sandbox or Dedicated.")
 	updateClusterCmd.Flags().String("fault-tolerance", "", "[OPTIONAL] Fault tolerance of the cluster. The possible values are NONE, NODE, ZONE, or REGION. Default NONE.")
 	updateClusterCmd.Flags().String("database-version", "", "[OPTIONAL] The database version of the cluster. Production, Innovation, Preview, or 'Early Access'.")
@@ -0,0 +1,79 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+)
+
+var waitClusterCmd = &cobra.Command{
+	Use:   "wait",
+	Short: "Wait for a condition on a cluster",
+	Long: `Wait until a cluster satisfies every --for condition, or --timeout expires.
+Conditions are state=<STATE>, health=<HEALTH>, nodes-up=<all|N> and connection-pooling=<ENABLED|DISABLED>.
+Unlike --wait, the change does not have to come from a task started by this command.`,
+	Example: `ybm cluster wait --cluster-name my-cluster --for state=ACTIVE
+ybm cluster wait --cluster-name my-cluster --for health=HEALTHY --for nodes-up=all --timeout 30m`,
+	Run: func(cmd *cobra.Command, args []string) {
+		forFlags, _ := cmd.Flags().GetStringArray("for")
+		conditions := []ybm.ClusterCondition{}
+		for _, f := range forFlags {
+			condition, err := ybm.ParseClusterCondition(f)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			conditions = append(conditions, condition)
+		}
+
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+		clusterName, _ := cmd.Flags().GetString("cluster-name")
+		clusterID, err := authApi.GetClusterIdByName(clusterName)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		forDescription := make([]string, len(conditions))
+		for i, condition := range conditions {
+			forDescription[i] = condition.String()
+		}
+		msg := fmt.Sprintf("Waiting for the cluster %s to have %s", formatter.Colorize(clusterName, formatter.GREEN_COLOR), strings.Join(forDescription, ", "))
+		status, err := authApi.WaitForClusterConditions(clusterID, conditions, msg)
+		if err != nil {
+			logrus.Fatalf("error when getting cluster status: %s", err)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "The cluster %s has %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR), status)
+	},
+}
+
+func init() {
+	ClusterCmd.AddCommand(waitClusterCmd)
+	waitClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster.")
+	waitClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(waitClusterCmd, waitClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
+	waitClusterCmd.Flags().StringArray("for", []string{}, "[REQUIRED] Condition to wait for, such as state=ACTIVE, health=HEALTHY, nodes-up=all or connection-pooling=ENABLED. Can be repeated, all conditions must hold.")
+	waitClusterCmd.MarkFlagRequired("for")
+}
@@ -16,11 +16,18 @@
 package cmd_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -56,6 +63,15 @@ var _ = Describe("Cluster", func() {
 		os.Setenv("YBM_APIKEY", "test-token")
 	})
 
+	// Allow lists, nodes and CMK are fetched concurrently, so they are routed by path
+	// instead of relying on the order of the requests.
+	routeFullClusterHandlers := func() {
+		clusterPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"
+		server.RouteToHandler(http.MethodGet, clusterPath+"/allow-lists", ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseNetworkAllowList))
+		server.RouteToHandler(http.MethodGet, clusterPath+"/nodes", ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseNodes))
+		server.RouteToHandler(http.MethodGet, clusterPath+"/cmks", ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseCMK))
+	}
+
 	Describe("Pausing cluster", func() {
 		BeforeEach(func() {
 			statusCode = 200
@@ -83,7 +99,25 @@ var _ = Describe("Cluster", func() {
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say("The cluster stunning-sole is being paused"))
+				Expect(session.Err).Should(gbytes.Say("The cluster stunning-sole is being paused"))
+				session.Kill()
+			})
+			It("should write the status message to stderr with json output", func() {
+				statusCode = 200
+				err := loadJson("./test/fixtures/pause-cluster.json", &responseCluster)
+				Expect(err).ToNot(HaveOccurred())
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/pause"),
+						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseCluster),
+					),
+				)
+				cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-name", "stunning-sole", "-o", "json")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.Err).Should(gbytes.Say("The cluster stunning-sole is being paused"))
+				Expect(string(session.Out.Contents())).ToNot(ContainSubstring("is being paused"))
 				session.Kill()
 			})
 			It("should failed if cluster is already paused", func() {
@@ -151,7 +185,7 @@ var _ = Describe("Cluster", func() {
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say("The cluster stunning-sole is being resumed"))
+				Expect(session.Err).Should(gbytes.Say("The cluster stunning-sole is being resumed"))
 				session.Kill()
 			})
 			It("should failed if cluster is already paused", func() {
@@ -218,7 +252,17 @@ stunning-sole   Dedicated   2.16.0.1-b7   ACTIVE    💚        AWS        us-we
 				session.Kill()
 			})
 
-			It("should return detailed summary of cluster if cluster-name is specified", func() {
+			It("should complete cluster names and IDs", func() {
+				cmd := exec.Command(compiledCLIPath, "__complete", "cluster", "describe", "--cluster-id", "5f80")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.ExitCode()).To(Equal(0))
+				Expect(session.Out).Should(gbytes.Say("5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8\tstunning-sole\n:4\n"))
+				session.Kill()
+			})
+
+			It("should return the full view of every cluster with --details", func() {
 				statusCode = 200
 				err := loadJson("./test/fixtures/allow-list.json", &responseNetworkAllowList)
 				Expect(err).ToNot(HaveOccurred())
@@ -226,26 +270,33 @@ stunning-sole   Dedicated   2.16.0.1-b7   ACTIVE    💚        AWS        us-we
 				Expect(err).ToNot(HaveOccurred())
 				err = loadJson("./test/fixtures/aws_cmk.json", &responseCMK)
 				Expect(err).ToNot(HaveOccurred())
-				err = loadJson("./test/fixtures/one-cluster.json", &responseCluster)
+				routeFullClusterHandlers()
+				cmd := exec.Command(compiledCLIPath, "cluster", "list", "--details", "-o", "json")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.ExitCode()).To(Equal(0))
+				// One record per line
+				lines := strings.Split(strings.TrimSpace(string(session.Out.Contents())), "\n")
+				Expect(lines).To(HaveLen(1))
+				var fullCluster map[string]interface{}
+				Expect(json.Unmarshal([]byte(lines[0]), &fullCluster)).To(Succeed())
+				Expect(fullCluster["providers"]).To(Equal([]interface{}{"AWS"}))
+				Expect(fullCluster["allow_lists"]).To(HaveLen(1))
+				Expect(fullCluster["nodes"]).To(HaveLen(3))
+				Expect(fullCluster["cmk"]).To(HaveLen(1))
+				session.Kill()
+			})
+
+			It("should return detailed summary of cluster if cluster-name is specified", func() {
+				statusCode = 200
+				err := loadJson("./test/fixtures/allow-list.json", &responseNetworkAllowList)
 				Expect(err).ToNot(HaveOccurred())
-				server.AppendHandlers(
-					ghttp.CombineHandlers(
-						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/allow-lists"),
-						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNetworkAllowList),
-					),
-					ghttp.CombineHandlers(
-						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/nodes"),
-						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNodes),
-					),
-					ghttp.CombineHandlers(
-						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/cmks"),
-						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseCMK),
-					),
-					ghttp.CombineHandlers(
-						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"),
-						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseCluster),
-					),
-				)
+				err = loadJson("./test/fixtures/nodes.json", &responseNodes)
+				Expect(err).ToNot(HaveOccurred())
+				err = loadJson("./test/fixtures/aws_cmk.json", &responseCMK)
+				Expect(err).ToNot(HaveOccurred())
+				routeFullClusterHandlers()
 				cmd := exec.Command(compiledCLIPath, "cluster", "describe", "--cluster-name", "stunning-sole")
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
@@ -298,14 +349,182 @@ test-cli-2-n3   us-west-2[us-west-2c]   💚        ❌        ✅        ❌
 						ghttp.VerifyFormKV("name", "test"),
 					),
 				)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters"),
+						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseCluster),
+					),
+				)
 				cmd := exec.Command(compiledCLIPath, "cluster", "describe", "--cluster-name", "test")
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(
+				Expect(session.Err).Should(gbytes.Say(
 					`No cluster found`))
 				session.Kill()
 			})
+			It("should suggest the closest cluster name when cluster-name is wrong", func() {
+				statusCode = 200
+				err := loadJson("./test/fixtures/no-clusters.json", &responseCluster)
+				Expect(err).ToNot(HaveOccurred())
+				server.SetHandler(2,
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters"),
+						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseCluster),
+						ghttp.VerifyFormKV("name", "stuning-sole"),
+					),
+				)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters"),
+						ghttp.RespondWithJSONEncodedPtr(&statusCode, responseListCluster),
+					),
+				)
+				cmd := exec.Command(compiledCLIPath, "cluster", "describe", "--cluster-name", "stuning-sole")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.Err).Should(gbytes.Say(
+					`No cluster found, did you mean stunning-sole\?`))
+				session.Kill()
+			})
+			It("should describe the cluster given by cluster-id", func() {
+				statusCode = 200
+				var responseOneCluster openapi.ClusterResponse
+				err := loadJson("./test/fixtures/one-cluster.json", &responseOneCluster)
+				Expect(err).ToNot(HaveOccurred())
+				server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8",
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseOneCluster),
+				)
+				cmd := exec.Command(compiledCLIPath, "cluster", "describe", "--cluster-id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "-o", "json")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.ExitCode()).To(Equal(0))
+				Expect(string(session.Out.Contents())).To(ContainSubstring("stunning-sole"))
+				session.Kill()
+			})
+			It("should reject both cluster-name and cluster-id", func() {
+				cmd := exec.Command(compiledCLIPath, "cluster", "describe", "--cluster-name", "stunning-sole", "--cluster-id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				session.Wait(2)
+				Expect(session.Err).Should(gbytes.Say("none of the others can be"))
+				session.Kill()
+			})
+		})
+	})
+
+	Describe("Pausing cluster by ID", func() {
+		It("should check the ID and show the name of the cluster", func() {
+			oneCluster, err := os.ReadFile("./test/fixtures/one-cluster.json")
+			Expect(err).ToNot(HaveOccurred())
+			clusterPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"
+			server.RouteToHandler(http.MethodGet, clusterPath, ghttp.RespondWith(http.StatusOK, oneCluster))
+			server.RouteToHandler(http.MethodPost, clusterPath+"/pause", ghttp.RespondWith(http.StatusOK, oneCluster))
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Err).Should(gbytes.Say("The cluster stunning-sole is being paused"))
+			session.Kill()
+		})
+	})
+
+	Describe("Pausing several clusters", func() {
+		It("should wait for every cluster and summarize the outcome", func() {
+			oneCluster, err := os.ReadFile("./test/fixtures/one-cluster.json")
+			Expect(err).ToNot(HaveOccurred())
+			clusterIds := map[string]string{
+				"5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8": "SUCCEEDED",
+				"0c5e8b1a-7d2f-4a3b-9e6c-1f2a3b4c5d6e": "FAILED",
+			}
+			clustersPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/"
+			for clusterId := range clusterIds {
+				cluster := strings.ReplaceAll(string(oneCluster), "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", clusterId)
+				server.RouteToHandler(http.MethodGet, clustersPath+clusterId, ghttp.RespondWith(http.StatusOK, cluster))
+				server.RouteToHandler(http.MethodPost, clustersPath+clusterId+"/pause", ghttp.RespondWith(http.StatusOK, cluster))
+			}
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks", func(w http.ResponseWriter, r *http.Request) {
+				clusterId := r.URL.Query().Get("entity_id")
+				fmt.Fprintf(w, `{"data": [{"info": {"id": "task-%s", "task_type": "PAUSE_CLUSTER", "entity_type": "CLUSTER", "entity_id": "%s", "state": "%s"}}]}`, clusterId, clusterId, clusterIds[clusterId])
+			})
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-name", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "--cluster-name", "0c5e8b1a-7d2f-4a3b-9e6c-1f2a3b4c5d6e", "--wait", "--poll-interval", "100ms")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session).Should(gexec.Exit(1))
+			output := string(session.Out.Contents())
+			Expect(output).To(MatchRegexp(`Name\s+Type\s+State\s+Duration\s+Error`))
+			Expect(output).To(MatchRegexp(`5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8\s+PAUSE_CLUSTER\s+SUCCEEDED`))
+			Expect(output).To(MatchRegexp(`0c5e8b1a-7d2f-4a3b-9e6c-1f2a3b4c5d6e\s+PAUSE_CLUSTER\s+FAILED`))
+			Expect(session.Err).Should(gbytes.Say("1 of 2 operations did not succeed"))
+			session.Kill()
+		})
+
+		It("should report a timeout when it expires during a request", func() {
+			oneCluster, err := os.ReadFile("./test/fixtures/one-cluster.json")
+			Expect(err).ToNot(HaveOccurred())
+			clustersPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/"
+			clusterIds := []string{"5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "0c5e8b1a-7d2f-4a3b-9e6c-1f2a3b4c5d6e"}
+			for _, clusterId := range clusterIds {
+				cluster := strings.ReplaceAll(string(oneCluster), "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", clusterId)
+				server.RouteToHandler(http.MethodGet, clustersPath+clusterId, ghttp.RespondWith(http.StatusOK, cluster))
+				server.RouteToHandler(http.MethodPost, clustersPath+clusterId+"/pause", ghttp.RespondWith(http.StatusOK, cluster))
+			}
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks", func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(2 * time.Second):
+				}
+			})
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-name", clusterIds[0], "--cluster-name", clusterIds[1], "--wait", "--timeout", "300ms")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session).Should(gexec.Exit(1))
+			output := string(session.Out.Contents())
+			Expect(output).To(MatchRegexp(`5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8\s+PAUSE_CLUSTER\s+TIMEOUT`))
+			Expect(output).ToNot(ContainSubstring("ERROR"))
+			session.Kill()
+		})
+	})
+
+	Describe("Waiting for a cluster", func() {
+		BeforeEach(func() {
+			statusCode = 200
+			var responseOneCluster openapi.ClusterResponse
+			err := loadJson("./test/fixtures/one-cluster.json", &responseOneCluster)
+			Expect(err).ToNot(HaveOccurred())
+			err = loadJson("./test/fixtures/nodes.json", &responseNodes)
+			Expect(err).ToNot(HaveOccurred())
+			clusterPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"
+			server.RouteToHandler(http.MethodGet, clusterPath, ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseOneCluster))
+			server.RouteToHandler(http.MethodGet, clusterPath+"/nodes", ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseNodes))
+		})
+
+		It("should return once every condition holds", func() {
+			cmd := exec.Command(compiledCLIPath, "cluster", "wait", "--cluster-id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "--for", "state=active", "--for", "health=HEALTHY", "--for", "nodes-up=all")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Err).Should(gbytes.Say("has state=ACTIVE health=HEALTHY nodes-up=3/3 connection-pooling=DISABLED"))
+			session.Kill()
+		})
+
+		It("should reject an unknown condition", func() {
+			cmd := exec.Command(compiledCLIPath, "cluster", "wait", "--cluster-id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "--for", "color=blue")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(1))
+			Expect(session.Err).Should(gbytes.Say("unknown condition key 'color'"))
+			session.Kill()
 		})
 	})
 
@@ -431,6 +650,304 @@ test-cli-2-n3   us-west-2[us-west-2c]   💚        ❌        ✅        ❌
 		})
 	})
 
+	Describe("Creating cluster from a file", func() {
+		var clusterFile string
+
+		BeforeEach(func() {
+			clusterFile = filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+		})
+
+		It("should create the cluster of the file, overridden by the flags", func() {
+			statusCode = 200
+			err := loadJson("./test/fixtures/create-cluster-with-cp.json", &responseCluster)
+			Expect(err).ToNot(HaveOccurred())
+			err = os.WriteFile(clusterFile, []byte(`kind: Cluster
+spec:
+  name: file-cluster
+  cloud-provider: AWS
+  cluster-tier: Dedicated
+  regions:
+    - region: ap-northeast-1
+      num-nodes: 3
+      num-cores: 4
+      disk-size-gb: 200
+  credentials:
+    username: admin
+    password: TestPass123
+  enable-connection-pooling: true
+`), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			var receivedPayload []byte
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/clusters/supported-node-configurations",
+				func(w http.ResponseWriter, req *http.Request) {
+					data, _ := os.ReadFile("./test/fixtures/instances-type-aws-ap-northeast-1.json")
+					w.Header().Set("Content-Type", "application/json")
+					w.Write(data)
+				},
+			)
+			server.RouteToHandler(http.MethodPost, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						receivedPayload, _ = io.ReadAll(req.Body)
+					},
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseCluster),
+				),
+			)
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "create", "--from-file", clusterFile, "--cluster-name", "flag-cluster")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			payload := string(receivedPayload)
+			Expect(payload).Should(ContainSubstring(`"name":"flag-cluster"`))
+			Expect(payload).Should(ContainSubstring(`"cluster_tier":"PAID"`))
+			Expect(payload).Should(ContainSubstring(`"region":"ap-northeast-1"`))
+			Expect(payload).Should(ContainSubstring(`"num_nodes":3`))
+			Expect(payload).Should(ContainSubstring(`"ENABLE_CONNECTION_POOLING"`))
+			session.Kill()
+		})
+
+		It("should reject an unknown field of the file", func() {
+			err := os.WriteFile(clusterFile, []byte(`name: file-cluster
+regions:
+  - region: ap-northeast-1
+    num-node: 3
+`), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "create", "--from-file", clusterFile)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Err).Should(gbytes.Say(`is not a cluster spec: .*field num-node not found`))
+			Expect(session).Should(gexec.Exit(1))
+			session.Kill()
+		})
+	})
+
+	Describe("Dry run", func() {
+		It("should print the pause request without sending it", func() {
+			statusCode = 200
+			err := loadJson("./test/fixtures/list-clusters.json", &responseListCluster)
+			Expect(err).ToNot(HaveOccurred())
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters"),
+					ghttp.RespondWithJSONEncodedPtr(&statusCode, responseListCluster),
+				),
+			)
+			cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-name", "stunning-sole", "--dry-run")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Out).Should(gbytes.Say(`"method": "POST"`))
+			Expect(session.Out).Should(gbytes.Say(`"path": "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/pause"`))
+			Expect(session.Err).Should(gbytes.Say("Dry run, 1 request was not sent"))
+			for _, request := range server.ReceivedRequests() {
+				Expect(request.Method).To(Equal(http.MethodGet))
+			}
+			session.Kill()
+		})
+
+		It("should print the request for every cluster without waiting", func() {
+			oneCluster, err := os.ReadFile("./test/fixtures/one-cluster.json")
+			Expect(err).ToNot(HaveOccurred())
+			clustersPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters/"
+			clusterIds := []string{"5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "0c5e8b1a-7d2f-4a3b-9e6c-1f2a3b4c5d6e"}
+			for _, clusterId := range clusterIds {
+				cluster := strings.ReplaceAll(string(oneCluster), "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", clusterId)
+				server.RouteToHandler(http.MethodGet, clustersPath+clusterId, ghttp.RespondWith(http.StatusOK, cluster))
+			}
+			cmd := exec.Command(compiledCLIPath, "cluster", "pause", "--cluster-name", clusterIds[0], "--cluster-name", clusterIds[1], "--wait", "--dry-run")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session).Should(gexec.Exit(0))
+			for _, clusterId := range clusterIds {
+				Expect(session.Out).Should(gbytes.Say(`"path": "` + clustersPath + clusterId + `/pause"`))
+			}
+			Expect(session.Err).Should(gbytes.Say("Dry run, 2 requests were not sent"))
+			for _, request := range server.ReceivedRequests() {
+				Expect(request.Method).To(Equal(http.MethodGet))
+				Expect(request.URL.Path).ToNot(HaveSuffix("/tasks"))
+			}
+			session.Kill()
+		})
+
+		It("should print the redacted create request without sending it", func() {
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/clusters/supported-node-configurations",
+				func(w http.ResponseWriter, req *http.Request) {
+					data, _ := os.ReadFile("./test/fixtures/instances-type-aws-ap-northeast-1.json")
+					w.Header().Set("Content-Type", "application/json")
+					w.Write(data)
+				},
+			)
+			cmd := exec.Command(compiledCLIPath, "cluster", "create",
+				"--cluster-name", "dry-run-cluster",
+				"--credentials", "username=admin,password=TestPass123",
+				"--region-info", "region=ap-northeast-1,num-nodes=3,num-cores=4",
+				"--cloud-provider", "AWS",
+				"--cluster-tier", "Dedicated",
+				"--dry-run")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session).Should(gexec.Exit(0))
+			var request struct {
+				Method string                 `json:"method"`
+				Path   string                 `json:"path"`
+				Body   map[string]interface{} `json:"body"`
+			}
+			Expect(json.Unmarshal(session.Out.Contents(), &request)).To(Succeed())
+			Expect(request.Method).To(Equal(http.MethodPost))
+			Expect(request.Path).To(Equal("/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters"))
+			Expect(request.Body).To(HaveKeyWithValue("cluster_spec", HaveKeyWithValue("name", "dry-run-cluster")))
+			Expect(string(session.Out.Contents())).To(ContainSubstring(`"password": "********"`))
+			Expect(string(session.Out.Contents())).ToNot(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("TestPass123"))))
+			session.Kill()
+		})
+	})
+
+	Describe("Cloning cluster", func() {
+		BeforeEach(func() {
+			statusCode = 200
+			err := loadJson("./test/fixtures/list-clusters.json", &responseListCluster)
+			Expect(err).ToNot(HaveOccurred())
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/clusters",
+				ghttp.RespondWithJSONEncodedPtr(&statusCode, responseListCluster),
+			)
+		})
+
+		It("should fail when the new cluster already exists", func() {
+			cmd := exec.Command(compiledCLIPath, "cluster", "clone", "--source", "stunning-sole", "--name", "stunning-sole", "--credentials", "username=admin,password=TestPass123")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say("A cluster named stunning-sole already exists"))
+			Expect(session).Should(gexec.Exit(1))
+			for _, request := range server.ReceivedRequests() {
+				Expect(request.Method).To(Equal(http.MethodGet))
+			}
+			session.Kill()
+		})
+
+		It("should create the clone and copy the settings of the source", func() {
+			projectPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e"
+			sourcePath := projectPath + "/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"
+			clonePath := projectPath + "/clusters/9d1e6a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+			readFixture := func(name string) string {
+				data, err := os.ReadFile("./test/fixtures/" + name)
+				Expect(err).ToNot(HaveOccurred())
+				return string(data)
+			}
+			// The release track of the source is left out, so that its name is not looked up
+			sourceClusters := strings.ReplaceAll(readFixture("list-clusters.json"), `"6981a29d-8bce-45a7-ba95-efc7d3eeff84"`, "null")
+			cloneCluster := strings.ReplaceAll(strings.ReplaceAll(readFixture("one-cluster.json"), "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "9d1e6a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"), "stunning-sole", "staging")
+			var cloneData map[string]interface{}
+			Expect(json.Unmarshal([]byte(cloneCluster), &cloneData)).To(Succeed())
+			cloneList, err := json.Marshal(map[string]interface{}{"data": []interface{}{cloneData["data"]}})
+			Expect(err).ToNot(HaveOccurred())
+			var created atomic.Bool
+
+			server.RouteToHandler(http.MethodGet, projectPath+"/clusters", func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Query().Get("name") != "staging":
+					fmt.Fprint(w, sourceClusters)
+				case created.Load():
+					w.Write(cloneList)
+				default:
+					fmt.Fprint(w, `{"data": []}`)
+				}
+			})
+			server.RouteToHandler(http.MethodPost, projectPath+"/clusters", func(w http.ResponseWriter, r *http.Request) {
+				created.Store(true)
+				fmt.Fprint(w, cloneCluster)
+			})
+			server.RouteToHandler(http.MethodGet, clonePath, ghttp.RespondWith(http.StatusOK, cloneCluster))
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/clusters/supported-node-configurations",
+				ghttp.RespondWith(http.StatusOK, readFixture("instances-type-aws-ap-northeast-1.json")))
+			// Without a task, a wait is over
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks", ghttp.RespondWith(http.StatusOK, `{"data": []}`))
+
+			server.RouteToHandler(http.MethodGet, sourcePath+"/allow-lists", ghttp.RespondWith(http.StatusOK, readFixture("allow-list.json")))
+			server.RouteToHandler(http.MethodGet, projectPath+"/allow-lists", ghttp.RespondWith(http.StatusOK, readFixture("allow-list.json")))
+			server.RouteToHandler(http.MethodPut, clonePath+"/allow-lists", ghttp.RespondWith(http.StatusOK, readFixture("allow-list.json")))
+
+			server.RouteToHandler(http.MethodGet, sourcePath+"/cmks", ghttp.RespondWith(http.StatusOK, readFixture("aws_cmk.json")))
+			server.RouteToHandler(http.MethodGet, projectPath+"/telemetry-providers", ghttp.RespondWith(http.StatusOK, readFixture("list-telemetry-provider.json")))
+			server.RouteToHandler(http.MethodGet, projectPath+"/cluster/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/db-query-log-exporter-configs",
+				ghttp.RespondWith(http.StatusOK, readFixture("db-query-log-exporter-describe-resp.json")))
+			server.RouteToHandler(http.MethodPost, projectPath+"/cluster/9d1e6a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b/db-query-log-exporter-configs",
+				ghttp.RespondWith(http.StatusOK, readFixture("db-query-log-exporter.json")))
+			server.RouteToHandler(http.MethodGet, sourcePath+"/db-audit-log-exporter-configs", ghttp.RespondWith(http.StatusOK, readFixture("list-db-audit.json")))
+			server.RouteToHandler(http.MethodPost, clonePath+"/db-audit-log-exporter-configs", ghttp.RespondWith(http.StatusOK, readFixture("db-audit-data.json")))
+
+			server.RouteToHandler(http.MethodGet, sourcePath+"/pitr-configs", ghttp.RespondWith(http.StatusOK, readFixture("list-cluster-pitr-configs.json")))
+			server.RouteToHandler(http.MethodGet, clonePath+"/pitr-configs", ghttp.RespondWith(http.StatusOK, `{"data": []}`))
+			server.RouteToHandler(http.MethodGet, clonePath+"/namespaces", ghttp.RespondWith(http.StatusOK, readFixture("namespaces.json")))
+			server.RouteToHandler(http.MethodPost, clonePath+"/pitr-configs", ghttp.RespondWith(http.StatusOK, readFixture("create-cluster-pitr-config.json")))
+
+			server.RouteToHandler(http.MethodGet, sourcePath+"/backup-schedules", ghttp.RespondWith(http.StatusOK, readFixture("list-backup-schedules.json")))
+			server.RouteToHandler(http.MethodGet, clonePath+"/backup-schedules", ghttp.RespondWith(http.StatusOK, readFixture("list-backup-schedules.json")))
+
+			// The first exporter is the one of the source
+			metricsExporters := strings.Replace(readFixture("list-metrics-exporter.json"), `"cluster_ids": []`, `"cluster_ids": ["5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"]`, 1)
+			server.RouteToHandler(http.MethodGet, projectPath+"/metrics-exporter-configs", ghttp.RespondWith(http.StatusOK, metricsExporters))
+			assignedExporter := ""
+			assignMetricsExporter := func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assignedExporter = string(body)
+				fmt.Fprint(w, `{"data": {}}`)
+			}
+			server.RouteToHandler(http.MethodPut, regexp.MustCompile(clonePath+"/metrics-exporter"), assignMetricsExporter)
+			server.RouteToHandler(http.MethodPost, regexp.MustCompile(clonePath+"/metrics-exporter"), assignMetricsExporter)
+
+			cmd := exec.Command(compiledCLIPath, "cluster", "clone", "--source", "stunning-sole", "--name", "staging", "--credentials", "username=admin,password=TestPass123", "--poll-interval", "10ms")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(10)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Err).Should(gbytes.Say("The cluster staging has been created"))
+			Expect(session.Err).Should(gbytes.Say("PitrConfig staging/YSQL/test_ysql_db created"))
+			Expect(session.Err).Should(gbytes.Say("PitrConfig staging/YCQL/test_ycql_db created"))
+			Expect(session.Err).Should(gbytes.Say("BackupPolicy staging unchanged"))
+			Expect(session.Err).Should(gbytes.Say("Assigning Metrics Exporter Config ff with cluster staging"))
+			Expect(session.Err).Should(gbytes.Say("The cluster staging has been cloned from stunning-sole"))
+			Expect(session.Err).Should(gbytes.Say(`Not copied:
+  - encryption: the credentials of the customer managed key cannot be read`))
+			Expect(string(session.Err.Contents())).ToNot(ContainSubstring("metrics exporter:"))
+			Expect(string(session.Out.Contents())).To(ContainSubstring("staging"))
+			Expect(assignedExporter).To(ContainSubstring("129f7c97-81ae-47c7-8f9e-40ab4390093f"))
+
+			sent := []string{}
+			for _, request := range server.ReceivedRequests() {
+				if request.Method != http.MethodGet {
+					sent = append(sent, request.Method+" "+request.URL.Path)
+				}
+			}
+			Expect(sent).To(ContainElements(
+				"POST "+projectPath+"/clusters",
+				"PUT "+clonePath+"/allow-lists",
+				"POST "+projectPath+"/cluster/9d1e6a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b/db-query-log-exporter-configs",
+				"POST "+clonePath+"/db-audit-log-exporter-configs",
+				"POST "+clonePath+"/pitr-configs",
+			))
+			session.Kill()
+		})
+
+		It("should fail without a password", func() {
+			cmd := exec.Command(compiledCLIPath, "cluster", "clone", "--source", "stunning-sole", "--name", "staging", "--credentials", "username=admin")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say("The credentials must have a username and a password"))
+			Expect(session).Should(gexec.Exit(1))
+			session.Kill()
+		})
+	})
+
 	AfterEach(func() {
 		os.Args = args
 		server.Close()
@@ -35,6 +35,7 @@ var _ = BeforeSuite(func() {
 	var err error
 	compiledCLIPath, err = gexec.Build("github.com/yugabyte/ybm-cli")
 	os.Setenv("YBM_WAIT", "false")
+	os.Setenv("YBM_NO_CACHE", "true")
 	Expect(compiledCLIPath).ToNot(BeEmpty())
 	Expect(err).ToNot(HaveOccurred())
 })
@@ -71,7 +71,7 @@ var _ = Describe("Connection Pooling", func() {
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say("Connection Pooling for cluster stunning-sole is being enabled"))
+			Expect(session.Err).Should(gbytes.Say("Connection Pooling for cluster stunning-sole is being enabled"))
 			session.Kill()
 		})
 		It("should return required field name and type when not set", func() {
@@ -110,7 +110,7 @@ var _ = Describe("Connection Pooling", func() {
 			exec.Command(compiledCLIPath, "y")
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say("Connection Pooling for cluster stunning-sole is being disabled"))
+			Expect(session.Err).Should(gbytes.Say("Connection Pooling for cluster stunning-sole is being disabled"))
 			session.Kill()
 		})
 		It("should return required field name and type when not set", func() {
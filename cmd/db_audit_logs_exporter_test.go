@@ -87,8 +87,8 @@ var _ = Describe("DB Audit Logging", func() {
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
 
-			Expect(session.Out).Should(gbytes.Say(regexp.QuoteMeta(`Db audit logging is being enabled for cluster stunning-sole
-State     Integration Name
+			Expect(session.Err).Should(gbytes.Say(regexp.QuoteMeta(`Db audit logging is being enabled for cluster stunning-sole`)))
+			Expect(session.Out).Should(gbytes.Say(regexp.QuoteMeta(`State     Integration Name
 ACTIVE    datadog-tp
 
 Ysql Config Key      Ysql Config Value
@@ -236,7 +236,7 @@ log-statement-once   false`)))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`DB Audit Logging is being disabled for cluster stunning-sole`))
+			Expect(session.Err).Should(gbytes.Say(`DB Audit Logging is being disabled for cluster stunning-sole`))
 			session.Kill()
 		})
 		It("should return required field name and type when not set", func() {
@@ -286,8 +286,8 @@ log-statement-once   false`)))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(regexp.QuoteMeta(`DB audit logging configuration is being updated for cluster stunning-sole
-State     Integration Name
+			Expect(session.Err).Should(gbytes.Say(regexp.QuoteMeta(`DB audit logging configuration is being updated for cluster stunning-sole`)))
+			Expect(session.Out).Should(gbytes.Say(regexp.QuoteMeta(`State     Integration Name
 ACTIVE    datadog-tp
 
 Ysql Config Key      Ysql Config Value
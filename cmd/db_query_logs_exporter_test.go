@@ -208,7 +208,7 @@ log-line-prefix              \%m :\%r :\%u @ \%d :\[\%p\] : \%a :`))
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`Request submitted to disable DB query logging for the cluster, this may take a few minutes...
+				Expect(session.Err).Should(gbytes.Say(`Request submitted to disable DB query logging for the cluster, this may take a few minutes...
 You can check the status via \$ ybm cluster db-query-logging describe --cluster-name stunning-sole`))
 				Expect(server.ReceivedRequests()).Should(HaveLen(5))
 				session.Kill()
@@ -327,8 +327,8 @@ log-line-prefix              \%m :\%r :\%u @ \%d :\[\%p\] : \%a :`))
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`Request submitted to edit DB query log config for the cluster, this may take a few minutes...
-State     Integration Name
+				Expect(session.Err).Should(gbytes.Say(`Request submitted to edit DB query log config for the cluster, this may take a few minutes...`))
+				Expect(session.Out).Should(gbytes.Say(`State     Integration Name
 ACTIVE    datadog-tp
 
 Log Config Key               Log Config Value
@@ -412,8 +412,8 @@ log-line-prefix              \%m :\%r :\%u @ \%d :\[\%p\] : \%a :`))
 
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(`Request submitted to edit DB query log config for the cluster, this may take a few minutes...
-State     Integration Name
+				Expect(session.Err).Should(gbytes.Say(`Request submitted to edit DB query log config for the cluster, this may take a few minutes...`))
+				Expect(session.Out).Should(gbytes.Say(`State     Integration Name
 ACTIVE    datadog-tp-new
 
 Log Config Key               Log Config Value
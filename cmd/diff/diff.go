@@ -0,0 +1,121 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// ChangesExitCode is the exit code when the live resources differ, errors
+// exit with 1
+const ChangesExitCode = 2
+
+var DiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show what apply would change",
+	Long: `Compare manifests, or a cluster spec saved as JSON, with the live resources
+and show field by field what ybm apply would create or update. Only the fields
+set in the manifests are compared.
+
+The exit code is 0 without changes, 2 with changes and 1 on error.`,
+	Example: `ybm diff -f ./aeon
+ybm cluster describe --cluster-name prod -o json > prod.json && ybm diff --cluster-spec prod.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, _ := cmd.Flags().GetStringArray("filename")
+		var manifests []manifest.Manifest
+		if len(paths) > 0 {
+			var err error
+			manifests, err = manifest.Load(paths...)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+		}
+
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+		reader := manifest.NewReader(authApi)
+
+		if cmd.Flags().Changed("cluster-spec") {
+			path, _ := cmd.Flags().GetString("cluster-spec")
+			clusterSpec, err := readClusterSpec(path)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			spec, err := reader.FromClusterSpec(*clusterSpec)
+			if err != nil {
+				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+			}
+			manifests = append(manifests, manifest.Manifest{Kind: manifest.KindCluster, Spec: spec, Source: path})
+		}
+
+		diffs, err := manifest.Plan(reader, manifests)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		if len(diffs) == 0 {
+			fmt.Fprintln(formatter.StatusOutput(), "No changes, the live resources match the manifests")
+			return
+		}
+		diffCtx := formatter.Context{
+			Output: os.Stdout,
+			Format: formatter.NewResourceDiffFormat(viper.GetString("output")),
+		}
+		formatter.ResourceDiffWrite(diffCtx, diffs)
+		logrus.Exit(ChangesExitCode)
+	},
+}
+
+// readClusterSpec reads a cluster spec saved as JSON, either the spec alone or
+// a whole cluster as output by cluster describe -o json
+func readClusterSpec(path string) (*ybmclient.ClusterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cluster struct {
+		Spec *ybmclient.ClusterSpec `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &cluster); err != nil {
+		return nil, fmt.Errorf("%s is not a cluster spec: %s", path, err)
+	}
+	if cluster.Spec != nil {
+		return cluster.Spec, nil
+	}
+	clusterSpec := &ybmclient.ClusterSpec{}
+	if err := json.Unmarshal(data, clusterSpec); err != nil {
+		return nil, fmt.Errorf("%s is not a cluster spec: %s", path, err)
+	}
+	return clusterSpec, nil
+}
+
+func init() {
+	DiffCmd.Flags().StringArrayP("filename", "f", []string{}, "Manifest file, or directory of .yaml, .yml and .json manifest files. Repeat the flag to compare several.")
+	DiffCmd.Flags().String("cluster-spec", "", "JSON file of a cluster spec, or of a cluster as output by cluster describe -o json.")
+	DiffCmd.MarkFlagsOneRequired("filename", "cluster-spec")
+}
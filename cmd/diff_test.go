@@ -0,0 +1,125 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	openapi "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Diff", func() {
+
+	var (
+		server          *ghttp.Server
+		statusCode      int
+		args            []string
+		responseAccount openapi.AccountResponse
+		responseProject openapi.AccountResponse
+		responseNAL     openapi.NetworkAllowListListResponse
+		manifestPath    string
+	)
+
+	writeManifest := func(content string) {
+		manifestPath = filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+		Expect(os.WriteFile(manifestPath, []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		args = os.Args
+		os.Args = []string{}
+		var err error
+		server, err = newGhttpServer(responseAccount, responseProject)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("YBM_HOST", fmt.Sprintf("http://%s", server.Addr()))
+		os.Setenv("YBM_APIKEY", "test-token")
+		statusCode = 200
+		err = loadJson("./test/fixtures/allow-list.json", &responseNAL)
+		Expect(err).ToNot(HaveOccurred())
+		server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists",
+			ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+		)
+	})
+
+	Context("When comparing manifests", func() {
+
+		It("should show the changed fields and exit with 2", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.43/32]
+---
+kind: NetworkAllowList
+spec:
+  name: office
+  ip-addresses: [203.0.113.0/24]
+`)
+			cmd := exec.Command(compiledCLIPath, "diff", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Out).Should(gbytes.Say(`Action    Resource                           Field          Live               Desired
+update    NetworkAllowList device-ip-gween   ip-addresses   152.165.26.42/32   152.165.26.43/32
+create    NetworkAllowList office`))
+			Expect(session.ExitCode()).To(Equal(2))
+			session.Kill()
+		})
+
+		It("should output a record per resource in json", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.43/32]
+`)
+			cmd := exec.Command(compiledCLIPath, "diff", "-f", manifestPath, "-o", "json")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Out).Should(gbytes.Say(`{"kind":"NetworkAllowList","name":"device-ip-gween","action":"update","changes":\[{"path":"ip-addresses","live":"152.165.26.42/32","desired":"152.165.26.43/32"}\]}`))
+			Expect(session.ExitCode()).To(Equal(2))
+			session.Kill()
+		})
+
+		It("should exit with 0 without changes", func() {
+			writeManifest(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.42/32]
+`)
+			cmd := exec.Command(compiledCLIPath, "diff", "-f", manifestPath)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`No changes, the live resources match the manifests`))
+			Expect(session.ExitCode()).To(Equal(0))
+			session.Kill()
+		})
+	})
+
+	AfterEach(func() {
+		os.Args = args
+		server.Close()
+	})
+})
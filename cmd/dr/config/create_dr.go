@@ -88,7 +88,7 @@ var createDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The DR %s has been created\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The DR %s has been created\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(sourceClusterId, drId).Execute()
 			if err != nil {
@@ -97,7 +97,7 @@ var createDrCmd = &cobra.Command{
 			}
 			drResp = drGetResp
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		drCtx := formatter.Context{
@@ -116,8 +116,10 @@ func init() {
 	createDrCmd.MarkFlagRequired("name")
 	createDrCmd.Flags().String("source-cluster", "", "[REQUIRED] Source cluster in the DR configuration.")
 	createDrCmd.MarkFlagRequired("source-cluster")
+	ybmAuthClient.AddIdFlag(createDrCmd, createDrCmd.Flags(), "source-cluster", "source-cluster-id", "source cluster")
 	createDrCmd.Flags().String("target-cluster", "", "[REQUIRED] Target cluster in the DR configuration.")
 	createDrCmd.MarkFlagRequired("target-cluster")
+	ybmAuthClient.AddIdFlag(createDrCmd, createDrCmd.Flags(), "target-cluster", "target-cluster-id", "target cluster")
 	createDrCmd.Flags().StringArray("databases", []string{}, "[REQUIRED] Databases to be replicated. Please provide a comma separated list of database names <db-name-1>,<db-name-2>.")
 	createDrCmd.MarkFlagRequired("databases")
 }
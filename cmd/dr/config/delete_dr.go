@@ -74,10 +74,10 @@ var deleteDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The DR %s has been deleted\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The DR %s has been deleted\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 			return
 		}
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	},
 }
 
@@ -85,6 +85,7 @@ func init() {
 	ConfigCmd.AddCommand(deleteDrCmd)
 	deleteDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration")
 	deleteDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(deleteDrCmd, deleteDrCmd.Flags(), "config", "config-id", "DR configuration")
 	deleteDrCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 }
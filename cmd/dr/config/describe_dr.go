@@ -16,13 +16,15 @@
 package config
 
 import (
-	"os"
+	"fmt"
+	"io"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/watch"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -47,18 +49,23 @@ var describeDrCmd = &cobra.Command{
 		}
 		drId := drInfo.GetId()
 		clusterId := drInfo.GetSourceClusterId()
-		drResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
-		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
+		err = watch.Run(cmd, args, func(out io.Writer) error {
+			drResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
+			if err != nil {
+				logrus.Debugf("Full HTTP response: %v", r)
+				return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+			}
 
-		drCtx := formatter.Context{
-			Output: os.Stdout,
-			Format: formatter.NewDrFormat(viper.GetString("output")),
-		}
+			drCtx := formatter.Context{
+				Output: out,
+				Format: formatter.NewDrFormat(viper.GetString("output")),
+			}
 
-		formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drResp.GetData()}, *authApi)
+			return formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drResp.GetData()}, *authApi)
+		})
+		if err != nil {
+			logrus.Fatal(err)
+		}
 	},
 }
 
@@ -66,4 +73,6 @@ func init() {
 	ConfigCmd.AddCommand(describeDrCmd)
 	describeDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	describeDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(describeDrCmd, describeDrCmd.Flags(), "config", "config-id", "DR configuration")
+	watch.AddFlag(describeDrCmd)
 }
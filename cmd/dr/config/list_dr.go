@@ -51,7 +51,7 @@ var listDrCmd = &cobra.Command{
 			Format: formatter.NewDrFormat(viper.GetString("output")),
 		}
 		if len(resp.GetData()) < 1 {
-			fmt.Println("No DRs found")
+			fmt.Fprintln(formatter.StatusOutput(), "No DRs found")
 			return
 		}
 		formatter.DrWrite(drsCtx, resp.GetData(), *authApi)
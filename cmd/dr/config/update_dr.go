@@ -87,7 +87,7 @@ var updateDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DR config %s has been updated\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DR config %s has been updated\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -96,7 +96,7 @@ var updateDrCmd = &cobra.Command{
 			}
 			drResp = drGetResp
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		drCtx := formatter.Context{
@@ -113,6 +113,7 @@ func init() {
 	ConfigCmd.AddCommand(updateDrCmd)
 	updateDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	updateDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(updateDrCmd, updateDrCmd.Flags(), "config", "config-id", "DR configuration")
 	updateDrCmd.Flags().StringArray("databases", []string{}, "[REQUIRED] Databases to be replicated. Please provide a comma separated list of database names <db-name-1>,<db-name-2>.")
 	updateDrCmd.MarkFlagRequired("databases")
 }
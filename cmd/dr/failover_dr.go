@@ -72,7 +72,7 @@ var failoverDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("Failover for DR config %s is successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "Failover for DR config %s is successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -86,7 +86,7 @@ var failoverDrCmd = &cobra.Command{
 
 			formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drGetResp.GetData()}, *authApi)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -96,4 +96,5 @@ func init() {
 	DrCmd.AddCommand(failoverDrCmd)
 	failoverDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	failoverDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(failoverDrCmd, failoverDrCmd.Flags(), "config", "config-id", "DR configuration")
 }
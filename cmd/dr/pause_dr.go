@@ -68,7 +68,7 @@ var pauseDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DR config %s is paused successfully\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DR config %s is paused successfully\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -82,7 +82,7 @@ var pauseDrCmd = &cobra.Command{
 
 			formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drGetResp.GetData()}, *authApi)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -92,5 +92,6 @@ func init() {
 	DrCmd.AddCommand(pauseDrCmd)
 	pauseDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	pauseDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(pauseDrCmd, pauseDrCmd.Flags(), "config", "config-id", "DR configuration")
 	pauseDrCmd.Flags().Int32("duration", 60, "[OPTIONAL] Duration in minutes.")
 }
@@ -89,7 +89,7 @@ var restartDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DR config %s is restarted successfully\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DR config %s is restarted successfully\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -103,7 +103,7 @@ var restartDrCmd = &cobra.Command{
 
 			formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drGetResp.GetData()}, *authApi)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -113,5 +113,6 @@ func init() {
 	DrCmd.AddCommand(restartDrCmd)
 	restartDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	restartDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(restartDrCmd, restartDrCmd.Flags(), "config", "config-id", "DR configuration")
 	restartDrCmd.Flags().StringArray("databases", []string{}, "[OPTIONAL] Databases to be restarted. Please provide a comma separated list of database names <db-name-1>,<db-name-2>.")
 }
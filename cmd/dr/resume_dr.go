@@ -65,7 +65,7 @@ var resumeDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("DR config %s is resumed successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "DR config %s is resumed successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -79,7 +79,7 @@ var resumeDrCmd = &cobra.Command{
 
 			formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drGetResp.GetData()}, *authApi)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -89,4 +89,5 @@ func init() {
 	DrCmd.AddCommand(resumeDrCmd)
 	resumeDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	resumeDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(resumeDrCmd, resumeDrCmd.Flags(), "config", "config-id", "DR configuration")
 }
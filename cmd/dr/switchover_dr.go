@@ -65,7 +65,7 @@ var switchoverDrCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("Switchover for DR config %s is successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "Switchover for DR config %s is successful\n", formatter.Colorize(drName, formatter.GREEN_COLOR))
 
 			drGetResp, r, err := authApi.GetXClusterDr(clusterId, drId).Execute()
 			if err != nil {
@@ -79,7 +79,7 @@ var switchoverDrCmd = &cobra.Command{
 
 			formatter.DrWrite(drCtx, []ybmclient.XClusterDrData{drGetResp.GetData()}, *authApi)
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 	},
@@ -89,4 +89,5 @@ func init() {
 	DrCmd.AddCommand(switchoverDrCmd)
 	switchoverDrCmd.Flags().String("config", "", "[REQUIRED] Name of the DR configuration.")
 	switchoverDrCmd.MarkFlagRequired("config")
+	ybmAuthClient.AddIdFlag(switchoverDrCmd, switchoverDrCmd.Flags(), "config", "config-id", "DR configuration")
 }
@@ -0,0 +1,95 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package drift
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+)
+
+// DriftExitCode is the exit code when the account drifted from the baseline,
+// errors exit with 1
+const DriftExitCode = 2
+
+var DriftCmd = &cobra.Command{
+	Use:   "drift",
+	Short: "Detect changes of the account since a baseline",
+	Long: `Compare the account with a baseline, as written by ybm export or maintained
+by hand, and report the resources added, removed or modified since: a new
+public endpoint, a changed allow list, a disabled backup policy, a changed
+node count...
+
+Only the fields the baseline sets are compared, and added resources are only
+reported for the kinds the baseline has resources of. Secrets are never
+compared, their placeholders do not need to be set.
+
+The output is a table, json, pretty or markdown (-o markdown). The exit code
+is 0 without drift, 2 with drift and 1 on error.`,
+	Example: `ybm export --dir ./baseline
+ybm drift --baseline ./baseline -o markdown`,
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, _ := cmd.Flags().GetStringArray("baseline")
+		baseline, err := manifest.LoadBaseline(paths...)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		live, errs := manifest.NewReader(authApi).Export()
+		baselineKinds := map[manifest.Kind]bool{}
+		for _, m := range baseline {
+			baselineKinds[m.Kind] = true
+		}
+		for _, err := range errs {
+			// Every resource of a kind that cannot be read would show as removed,
+			// the other kinds are not compared
+			if exportErr, ok := err.(*manifest.ExportError); ok && !baselineKinds[exportErr.Kind] {
+				logrus.Debug(err)
+				continue
+			}
+			logrus.Fatalf("%s, the drift cannot be computed", err)
+		}
+
+		diffs := manifest.Drift(baseline, live)
+		if len(diffs) == 0 {
+			fmt.Fprintln(formatter.StatusOutput(), "No drift, the account matches the baseline")
+			return
+		}
+		driftCtx := formatter.Context{
+			Output: os.Stdout,
+			Format: formatter.NewResourceDiffFormat(viper.GetString("output")),
+		}
+		formatter.DriftWrite(driftCtx, diffs)
+		logrus.Exit(DriftExitCode)
+	},
+}
+
+func init() {
+	DriftCmd.Flags().StringArray("baseline", []string{}, "[REQUIRED] Baseline manifest file, or directory of .yaml, .yml and .json manifest files. Repeat the flag to compare several.")
+	DriftCmd.MarkFlagRequired("baseline")
+}
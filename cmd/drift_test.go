@@ -0,0 +1,146 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	openapi "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Drift", func() {
+
+	var (
+		server          *ghttp.Server
+		statusCode      int
+		args            []string
+		responseAccount openapi.AccountResponse
+		responseProject openapi.AccountResponse
+		responseNAL     openapi.NetworkAllowListListResponse
+		responseVPC     openapi.SingleTenantVpcListResponse
+		baselineDir     string
+	)
+
+	writeBaseline := func(content string) {
+		baselineDir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(baselineDir, "baseline.yaml"), []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		args = os.Args
+		os.Args = []string{}
+		var err error
+		server, err = newGhttpServer(responseAccount, responseProject)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("YBM_HOST", fmt.Sprintf("http://%s", server.Addr()))
+		os.Setenv("YBM_APIKEY", "test-token")
+		statusCode = 200
+		err = loadJson("./test/fixtures/allow-list.json", &responseNAL)
+		Expect(err).ToNot(HaveOccurred())
+		err = loadJson("./test/fixtures/vpc-gcp-global.json", &responseVPC)
+		Expect(err).ToNot(HaveOccurred())
+		server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists",
+			ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+		)
+		server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/vpcs",
+			ghttp.RespondWithJSONEncodedPtr(&statusCode, responseVPC),
+		)
+		// The kinds missing from the baseline are not compared
+		server.AllowUnhandledRequests = true
+		server.UnhandledRequestStatusCode = http.StatusNotFound
+	})
+
+	Context("When the account drifted", func() {
+
+		It("should report the drift and exit with 2", func() {
+			writeBaseline(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.43/32]
+---
+kind: Vpc
+spec:
+  name: gwenn-gcp-jp3
+  cloud-provider: GCP
+  global-cidr: 10.10.0.0/16
+---
+kind: Vpc
+spec:
+  name: removed-vpc
+  cloud-provider: AWS
+`)
+			cmd := exec.Command(compiledCLIPath, "drift", "--baseline", baselineDir)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Out).Should(gbytes.Say(`Drift      Resource                           Field          Live               Baseline
+modified   NetworkAllowList device-ip-gween   ip-addresses   152.165.26.42/32   152.165.26.43/32
+removed    Vpc removed-vpc\s+
+added      Vpc gwenn-jp3`))
+			Expect(session.ExitCode()).To(Equal(2))
+			session.Kill()
+		})
+
+		It("should report the drift as markdown", func() {
+			writeBaseline(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.43/32]
+`)
+			cmd := exec.Command(compiledCLIPath, "drift", "--baseline", baselineDir, "-o", "markdown")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Out).Should(gbytes.Say(`\| Drift \| Resource \| Field \| Live \| Baseline \|
+\|---\|---\|---\|---\|---\|
+\| modified \| NetworkAllowList device-ip-gween \| ip-addresses \| 152.165.26.42/32 \| 152.165.26.43/32 \|`))
+			Expect(session.ExitCode()).To(Equal(2))
+			session.Kill()
+		})
+	})
+
+	Context("When the account matches the baseline", func() {
+
+		It("should exit with 0", func() {
+			writeBaseline(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  ip-addresses: [152.165.26.42/32]
+`)
+			cmd := exec.Command(compiledCLIPath, "drift", "--baseline", baselineDir)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Err).Should(gbytes.Say(`No drift, the account matches the baseline`))
+			Expect(session.ExitCode()).To(Equal(0))
+			session.Kill()
+		})
+	})
+
+	AfterEach(func() {
+		os.Args = args
+		server.Close()
+	})
+})
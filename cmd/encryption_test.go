@@ -163,7 +163,7 @@ AZURE      8aXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX5b   -              https://test-az
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say("Successfully updated encryption at rest for cluster stunning-sole"))
+				Expect(session.Err).Should(gbytes.Say("Successfully updated encryption at rest for cluster stunning-sole"))
 				session.Kill()
 			})
 		}
@@ -199,7 +199,7 @@ AZURE      8aXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX5b   -              https://test-az
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say("Successfully updated encryption at rest for cluster stunning-sole"))
+			Expect(session.Err).Should(gbytes.Say("Successfully updated encryption at rest for cluster stunning-sole"))
 			session.Kill()
 		})
 	})
@@ -242,7 +242,7 @@ AZURE      8aXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX5b   -              https://test-az
 				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
 				session.Wait(2)
-				Expect(session.Out).Should(gbytes.Say(tc.expected))
+				Expect(session.Err).Should(gbytes.Say(tc.expected))
 				session.Kill()
 			})
 		}
@@ -0,0 +1,182 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the resources of the account to files",
+	Long: `Export the resources of the account to a directory, one file per resource:
+clusters with their network allow lists, encryption, DB query and audit
+logging, metrics exporter, endpoints, backup policy and PITR configs, VPCs, VPC
+peerings, network allow lists, custom roles, integrations, DR configs and CDC
+sinks and streams.
+
+The files are manifests that ybm diff and ybm apply read. Secrets are never
+exported, they are replaced with ${NAME} placeholders that apply reads from
+the environment. Existing files of the same resources are replaced.
+
+With --format terraform, the clusters, allow lists, VPCs, VPC peerings and
+backup schedules are written instead as configuration of the YugabyteDB Aeon
+Terraform provider, with an import block per resource so that terraform plan
+adopts the existing resources. The cluster credentials are variables. Run
+terraform fmt on the directory to align the attributes.`,
+	Example: `ybm export --dir ./aeon
+ybm export --dir ./aeon-tf --format terraform`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, _ := cmd.Flags().GetString("dir")
+		format, _ := cmd.Flags().GetString("format")
+		if format != "yaml" && format != "json" && format != "terraform" {
+			logrus.Fatalf("--format must be either yaml, json or terraform")
+		}
+
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		if format == "terraform" {
+			resources, errs := exportTerraform(authApi, dir)
+			for _, err := range errs {
+				logrus.Warn(err)
+			}
+			fmt.Fprintf(formatter.StatusOutput(), "Exported %d resources to Terraform files in %s\n", resources, formatter.Colorize(dir, formatter.GREEN_COLOR))
+			return
+		}
+
+		manifests, errs := manifest.NewReader(authApi).Export()
+		for _, err := range errs {
+			logrus.Warn(err)
+		}
+
+		files, err := Write(dir, format, manifests)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "Exported %d resources to %d files in %s\n", len(manifests), files, formatter.Colorize(dir, formatter.GREEN_COLOR))
+	},
+}
+
+// Write writes manifests to files of dir in the yaml or json format, and
+// returns how many files it wrote. A cluster file also holds the settings,
+// backup policy and PITR configs of the cluster.
+func Write(dir string, format string, manifests []manifest.Manifest) (int, error) {
+	var files []string
+	byFile := map[string][]manifest.Manifest{}
+	for _, m := range manifests {
+		file := filepath.Join(dir, fileOf(m)+"."+format)
+		if _, ok := byFile[file]; !ok {
+			files = append(files, file)
+		}
+		byFile[file] = append(byFile[file], m)
+	}
+	for _, file := range files {
+		data, err := encode(format, byFile[file])
+		if err != nil {
+			return 0, err
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return 0, err
+		}
+		if err := os.WriteFile(file, data, 0644); err != nil {
+			return 0, err
+		}
+	}
+	return len(files), nil
+}
+
+// fileOf returns the path of the file of a manifest, without extension
+func fileOf(m manifest.Manifest) string {
+	switch spec := m.Spec.(type) {
+	case *manifest.ClusterSpec:
+		return filepath.Join("clusters", fileName(spec.Name))
+	case *manifest.BackupPolicySpec:
+		return filepath.Join("clusters", fileName(spec.Cluster))
+	case *manifest.PitrConfigSpec:
+		return filepath.Join("clusters", fileName(spec.Cluster))
+	case *manifest.ResourceSpec:
+		if spec.Name == "" {
+			return filepath.Join("clusters", fileName(spec.Cluster))
+		}
+	}
+	return filepath.Join(kindDirs[m.Kind], fileName(m.Spec.Key()))
+}
+
+// kindDirs are the directories of the kinds that have their own files
+var kindDirs = map[manifest.Kind]string{
+	manifest.KindNetworkAllowList: "network-allow-lists",
+	manifest.KindVpc:              "vpcs",
+	manifest.KindVpcPeering:       "vpc-peerings",
+	manifest.KindIntegration:      "integrations",
+	manifest.KindRole:             "roles",
+	manifest.KindDrConfig:         "dr-configs",
+	manifest.KindCdcSink:          "cdc-sinks",
+	manifest.KindCdcStream:        "cdc-streams",
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fileName turns a resource name into a file name
+func fileName(name string) string {
+	return unsafeFileChars.ReplaceAllString(name, "-")
+}
+
+// encode returns the manifests of a file, as a YAML stream or a JSON object,
+// or a JSON list when there are several
+func encode(format string, manifests []manifest.Manifest) ([]byte, error) {
+	if format == "json" {
+		var value interface{} = manifests
+		if len(manifests) == 1 {
+			value = manifests[0]
+		}
+		data, err := json.MarshalIndent(value, "", "  ")
+		return append(data, '\n'), err
+	}
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	for _, m := range manifests {
+		if err := encoder.Encode(m); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func init() {
+	ExportCmd.Flags().String("dir", "", "[REQUIRED] Directory to write the files to.")
+	ExportCmd.MarkFlagRequired("dir")
+	ExportCmd.Flags().String("format", "yaml", "[OPTIONAL] Format of the files, either yaml, json or terraform.")
+}
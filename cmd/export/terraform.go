@@ -0,0 +1,324 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/cluster"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// terraformProvider is the registry source of the YugabyteDB Aeon provider
+const terraformProvider = "yugabyte/ybm"
+
+// terraformConfig accumulates the HCL of the resources of the account, a file
+// per resource type, with an import block per resource
+type terraformConfig struct {
+	files     map[string]*bytes.Buffer
+	names     map[string]map[string]bool
+	imports   bytes.Buffer
+	variables bytes.Buffer
+	resources int
+
+	// References to the exported resources by ID
+	allowListRefs map[string]string
+	vpcRefs       map[string]string
+}
+
+func newTerraformConfig() *terraformConfig {
+	return &terraformConfig{
+		files:         map[string]*bytes.Buffer{},
+		names:         map[string]map[string]bool{},
+		allowListRefs: map[string]string{},
+		vpcRefs:       map[string]string{},
+	}
+}
+
+// exportTerraform writes the HCL of the clusters, allow lists, VPCs, peerings
+// and backup schedules of the account to dir, and returns how many resources
+// it exported and the resources it could not read
+func exportTerraform(authApi *ybmAuthClient.AuthApiClient, dir string) (int, []error) {
+	tf := newTerraformConfig()
+	var errs []error
+
+	allowListResp, r, err := authApi.ListNetworkAllowLists().Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		errs = append(errs, fmt.Errorf("could not export the allow lists: %s", ybmAuthClient.GetApiErrorDetails(err)))
+	}
+	for _, allowList := range allowListResp.GetData() {
+		tf.addAllowList(allowList)
+	}
+
+	vpcResp, r, err := authApi.ListSingleTenantVpcs().Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		errs = append(errs, fmt.Errorf("could not export the VPCs: %s", ybmAuthClient.GetApiErrorDetails(err)))
+	}
+	for _, vpc := range vpcResp.GetData() {
+		tf.addVpc(vpc)
+	}
+
+	peeringResp, r, err := authApi.ListVpcPeerings().Execute()
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		errs = append(errs, fmt.Errorf("could not export the VPC peerings: %s", ybmAuthClient.GetApiErrorDetails(err)))
+	}
+	for _, peering := range peeringResp.GetData() {
+		tf.addVpcPeering(peering)
+	}
+
+	clusters, r, err := authApi.ListClustersPaged(authApi.ListClusters(), ybmAuthClient.AllPages)
+	if err != nil {
+		logrus.Debugf("Full HTTP response: %v", r)
+		errs = append(errs, fmt.Errorf("could not export the clusters: %s", ybmAuthClient.GetApiErrorDetails(err)))
+	}
+	for _, fullCluster := range cluster.NewFullClusters(*authApi, clusters, cluster.DefaultParallelism) {
+		clusterID := fullCluster.Cluster.Info.GetId()
+		trackName, err := authApi.GetTrackNameById(fullCluster.Cluster.Spec.SoftwareInfo.GetTrackId())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not export the cluster %s: %s", fullCluster.Cluster.Spec.Name, ybmAuthClient.GetApiErrorDetails(err)))
+			continue
+		}
+		var schedule *ybmclient.BackupScheduleDataV2
+		scheduleResp, r, err := authApi.ListBackupPoliciesV2(clusterID, false /* fetchOnlyActive */).Execute()
+		if err != nil {
+			logrus.Debugf("Full HTTP response: %v", r)
+			errs = append(errs, fmt.Errorf("could not export the backup schedule of the cluster %s: %s", fullCluster.Cluster.Spec.Name, ybmAuthClient.GetApiErrorDetails(err)))
+		} else if len(scheduleResp.GetData()) > 0 {
+			schedule = &scheduleResp.GetData()[0]
+		}
+		tf.addCluster(fullCluster, trackName, schedule)
+	}
+
+	if err := tf.write(dir); err != nil {
+		logrus.Fatal(err)
+	}
+	return tf.resources, errs
+}
+
+func (tf *terraformConfig) addAllowList(allowList ybmclient.NetworkAllowListData) {
+	name, b := tf.resource("ybm_allow_list", allowList.Spec.Name, allowList.Info.GetId())
+	tf.allowListRefs[allowList.Info.GetId()] = fmt.Sprintf("ybm_allow_list.%s.allow_list_id", name)
+	fmt.Fprintf(b, "  allow_list_name        = %s\n", hclString(allowList.Spec.Name))
+	fmt.Fprintf(b, "  allow_list_description = %s\n", hclString(allowList.Spec.Description))
+	fmt.Fprintf(b, "  cidr_list              = %s\n", hclStrings(allowList.Spec.AllowList))
+	b.WriteString("}\n")
+}
+
+func (tf *terraformConfig) addVpc(vpc ybmclient.SingleTenantVpcDataResponse) {
+	name, b := tf.resource("ybm_vpc", vpc.Spec.Name, vpc.Info.GetId())
+	ref := fmt.Sprintf("ybm_vpc.%s.vpc_id", name)
+	tf.vpcRefs[vpc.Info.GetId()] = ref
+	fmt.Fprintf(b, "  name  = %s\n", hclString(vpc.Spec.Name))
+	fmt.Fprintf(b, "  cloud = %s\n", hclString(string(vpc.Spec.GetCloud())))
+	if parentCidr := vpc.Spec.GetParentCidr(); parentCidr != "" {
+		fmt.Fprintf(b, "  global_cidr = %s\n", hclString(parentCidr))
+	} else {
+		b.WriteString("  region_cidr_info = [\n")
+		for _, regionSpec := range vpc.Spec.RegionSpecs {
+			fmt.Fprintf(b, "    {\n      region = %s\n      cidr   = %s\n    },\n", hclString(regionSpec.GetRegion()), hclString(regionSpec.GetCidr()))
+		}
+		b.WriteString("  ]\n")
+	}
+	b.WriteString("}\n")
+}
+
+func (tf *terraformConfig) addVpcPeering(peering ybmclient.VpcPeeringData) {
+	_, b := tf.resource("ybm_vpc_peering", peering.Spec.Name, peering.Info.GetId())
+	customerVpc := peering.Spec.CustomerVpc
+	fmt.Fprintf(b, "  name              = %s\n", hclString(peering.Spec.Name))
+	fmt.Fprintf(b, "  yugabytedb_vpc_id = %s\n", tf.ref(tf.vpcRefs, peering.Spec.InternalYugabyteVpcId))
+	b.WriteString("  application_vpc_info = {\n")
+	fmt.Fprintf(b, "    cloud   = %s\n", hclString(string(customerVpc.CloudInfo.GetCode())))
+	fmt.Fprintf(b, "    project = %s\n", hclString(customerVpc.GetCloudProviderProject()))
+	if region := customerVpc.CloudInfo.GetRegion(); region != "" {
+		fmt.Fprintf(b, "    region  = %s\n", hclString(region))
+	}
+	fmt.Fprintf(b, "    vpc_id  = %s\n", hclString(customerVpc.GetExternalVpcId()))
+	if cidr := customerVpc.GetCidr(); cidr != "" {
+		fmt.Fprintf(b, "    cidr    = %s\n", hclString(cidr))
+	}
+	b.WriteString("  }\n}\n")
+}
+
+func (tf *terraformConfig) addCluster(fullCluster *cluster.FullCluster, trackName string, schedule *ybmclient.BackupScheduleDataV2) {
+	clusterData := fullCluster.Cluster
+	spec := clusterData.GetSpec()
+	clusterInfo := spec.ClusterInfo
+	name, b := tf.resource("ybm_cluster", spec.Name, clusterData.Info.GetId())
+	fmt.Fprintf(b, "  cluster_name    = %s\n", hclString(spec.Name))
+	fmt.Fprintf(b, "  cloud_type      = %s\n", hclString(string(spec.CloudInfo.GetCode())))
+	fmt.Fprintf(b, "  cluster_type    = %s\n", hclString(string(clusterInfo.GetClusterType())))
+	fmt.Fprintf(b, "  cluster_tier    = %s\n", hclString(string(clusterInfo.GetClusterTier())))
+	fmt.Fprintf(b, "  fault_tolerance = %s\n", hclString(string(clusterInfo.GetFaultTolerance())))
+	if numFaultsToTolerate, ok := clusterInfo.GetNumFaultsToTolerateOk(); ok && numFaultsToTolerate != nil {
+		fmt.Fprintf(b, "  num_faults_to_tolerate = %d\n", *numFaultsToTolerate)
+	}
+	fmt.Fprintf(b, "  database_track  = %s\n", hclString(trackName))
+
+	b.WriteString("  cluster_region_info = [\n")
+	for _, regionInfo := range spec.ClusterRegionInfo {
+		placementInfo := regionInfo.PlacementInfo
+		b.WriteString("    {\n")
+		fmt.Fprintf(b, "      region    = %s\n", hclString(placementInfo.CloudInfo.GetRegion()))
+		fmt.Fprintf(b, "      num_nodes = %d\n", placementInfo.GetNumNodes())
+		if nodeInfo, ok := regionInfo.GetNodeInfoOk(); ok && nodeInfo != nil {
+			fmt.Fprintf(b, "      num_cores    = %d\n", nodeInfo.GetNumCores())
+			fmt.Fprintf(b, "      disk_size_gb = %d\n", nodeInfo.GetDiskSizeGb())
+			if diskIops, ok := nodeInfo.GetDiskIopsOk(); ok && diskIops != nil {
+				fmt.Fprintf(b, "      disk_iops    = %d\n", *diskIops)
+			}
+		}
+		if vpcID, ok := placementInfo.GetVpcIdOk(); ok && vpcID != nil && *vpcID != "" {
+			fmt.Fprintf(b, "      vpc_id = %s\n", tf.ref(tf.vpcRefs, *vpcID))
+		}
+		b.WriteString("    },\n")
+	}
+	b.WriteString("  ]\n")
+
+	if len(fullCluster.AllowList) > 0 {
+		refs := make([]string, 0, len(fullCluster.AllowList))
+		for _, allowList := range fullCluster.AllowList {
+			refs = append(refs, tf.ref(tf.allowListRefs, allowList.Info.GetId()))
+		}
+		fmt.Fprintf(b, "  cluster_allow_list_ids = [%s]\n", strings.Join(refs, ", "))
+	}
+
+	if schedule != nil {
+		scheduleSpec := schedule.GetSpec()
+		b.WriteString("  backup_schedules = [\n    {\n")
+		fmt.Fprintf(b, "      state                    = %s\n", hclString(string(scheduleSpec.GetState())))
+		fmt.Fprintf(b, "      retention_period_in_days = %d\n", scheduleSpec.GetRetentionPeriodInDays())
+		if cronExpression := scheduleSpec.GetCronExpression(); cronExpression != "" {
+			fmt.Fprintf(b, "      cron_expression          = %s\n", hclString(cronExpression))
+		} else {
+			fmt.Fprintf(b, "      time_interval_in_days    = %d\n", scheduleSpec.GetTimeIntervalInDays())
+		}
+		if incrementalInterval := scheduleSpec.GetIncrementalIntervalInMinutes(); incrementalInterval != 0 {
+			fmt.Fprintf(b, "      incremental_interval_in_mins = %d\n", incrementalInterval)
+		}
+		b.WriteString("    },\n  ]\n")
+	}
+
+	// The credentials are never read back, they come from variables
+	fmt.Fprintf(b, "  credentials = {\n    username = var.%s_username\n    password = var.%s_password\n  }\n", name, name)
+	fmt.Fprintf(&tf.variables, "variable %q {\n  type      = string\n  sensitive = true\n}\n\n", name+"_username")
+	fmt.Fprintf(&tf.variables, "variable %q {\n  type      = string\n  sensitive = true\n}\n\n", name+"_password")
+	if len(fullCluster.CMK) > 0 {
+		b.WriteString("  # The cluster uses a customer managed key, add its cmk_spec\n")
+	}
+	b.WriteString("}\n")
+}
+
+// resource starts the block of a resource with a unique name derived from
+// the display name, adds its import block and returns the name and the
+// buffer to write its attributes to
+func (tf *terraformConfig) resource(resourceType string, displayName string, id string) (string, *bytes.Buffer) {
+	if tf.names[resourceType] == nil {
+		tf.names[resourceType] = map[string]bool{}
+	}
+	name := terraformName(displayName)
+	for i := 2; tf.names[resourceType][name]; i++ {
+		name = fmt.Sprintf("%s_%d", terraformName(displayName), i)
+	}
+	tf.names[resourceType][name] = true
+	tf.resources++
+
+	b, ok := tf.files[resourceType]
+	if !ok {
+		b = &bytes.Buffer{}
+		tf.files[resourceType] = b
+	} else {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "resource %q %q {\n", resourceType, name)
+	fmt.Fprintf(&tf.imports, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, name, hclString(id))
+	return name, b
+}
+
+// ref returns the reference to an exported resource, or its ID when the
+// resource was not exported
+func (tf *terraformConfig) ref(refs map[string]string, id string) string {
+	if ref, ok := refs[id]; ok {
+		return ref
+	}
+	return hclString(id)
+}
+
+// write writes a .tf file per resource type, with the provider, the
+// variables and the import blocks
+func (tf *terraformConfig) write(dir string) error {
+	files := map[string][]byte{
+		"provider.tf": []byte(fmt.Sprintf("terraform {\n  required_providers {\n    ybm = {\n      source = %q\n    }\n  }\n}\n", terraformProvider)),
+		"imports.tf":  tf.imports.Bytes(),
+	}
+	if tf.variables.Len() > 0 {
+		files["variables.tf"] = tf.variables.Bytes()
+	}
+	for resourceType, b := range tf.files {
+		files[strings.TrimPrefix(resourceType, "ybm_")+"s.tf"] = b.Bytes()
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var invalidTerraformChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// terraformName turns a display name into a Terraform identifier
+func terraformName(displayName string) string {
+	name := invalidTerraformChars.ReplaceAllString(displayName, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// hclString quotes a string for HCL, where ${ and %{ start templates
+func hclString(value string) string {
+	quoted := strconv.Quote(value)
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(quoted)
+}
+
+func hclStrings(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, hclString(value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
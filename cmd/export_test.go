@@ -0,0 +1,187 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	openapi "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Export", func() {
+
+	var (
+		server          *ghttp.Server
+		statusCode      int
+		args            []string
+		responseAccount openapi.AccountResponse
+		responseProject openapi.AccountResponse
+		responseNAL     openapi.NetworkAllowListListResponse
+		responseVPC     openapi.SingleTenantVpcListResponse
+		dir             string
+	)
+
+	BeforeEach(func() {
+		args = os.Args
+		os.Args = []string{}
+		var err error
+		server, err = newGhttpServer(responseAccount, responseProject)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("YBM_HOST", fmt.Sprintf("http://%s", server.Addr()))
+		os.Setenv("YBM_APIKEY", "test-token")
+		statusCode = 200
+		dir = GinkgoT().TempDir()
+		err = loadJson("./test/fixtures/allow-list.json", &responseNAL)
+		Expect(err).ToNot(HaveOccurred())
+		err = loadJson("./test/fixtures/vpc-gcp-global.json", &responseVPC)
+		Expect(err).ToNot(HaveOccurred())
+		server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/allow-lists",
+			ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL),
+		)
+		server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e/vpcs",
+			ghttp.RespondWithJSONEncodedPtr(&statusCode, responseVPC),
+		)
+		// The other resources are missing and reported as warnings
+		server.AllowUnhandledRequests = true
+		server.UnhandledRequestStatusCode = http.StatusNotFound
+	})
+
+	Context("When exporting the account", func() {
+
+		It("should write a yaml file per resource", func() {
+			cmd := exec.Command(compiledCLIPath, "export", "--dir", dir)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Err).Should(gbytes.Say(`could not export the Integration resources`))
+			Expect(session.Err).Should(gbytes.Say(`Exported 3 resources to 3 files in .*`))
+
+			data, err := os.ReadFile(filepath.Join(dir, "network-allow-lists", "device-ip-gween.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`kind: NetworkAllowList
+spec:
+  name: device-ip-gween
+  description: device-ip-gween
+  ip-addresses:
+    - 152.165.26.42/32
+`))
+			data, err = os.ReadFile(filepath.Join(dir, "vpcs", "gwenn-gcp-jp3.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`kind: Vpc
+spec:
+  name: gwenn-gcp-jp3
+  cloud-provider: GCP
+  global-cidr: 10.10.0.0/16
+`))
+			session.Kill()
+		})
+
+		It("should write json files", func() {
+			cmd := exec.Command(compiledCLIPath, "export", "--dir", dir, "--format", "json")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			data, err := os.ReadFile(filepath.Join(dir, "vpcs", "gwenn-jp3.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"kind":"Vpc","spec":{"name":"gwenn-jp3","cloud-provider":"AWS","regions":[{"region":"ap-northeast-3","cidr":"10.7.0.0/24"}]}}`))
+			session.Kill()
+		})
+
+		It("should write terraform files with import blocks", func() {
+			cmd := exec.Command(compiledCLIPath, "export", "--dir", dir, "--format", "terraform")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			Expect(session.Err).Should(gbytes.Say(`could not export the VPC peerings`))
+			Expect(session.Err).Should(gbytes.Say(`Exported 3 resources to Terraform files in .*`))
+
+			data, err := os.ReadFile(filepath.Join(dir, "allow_lists.tf"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`resource "ybm_allow_list" "device-ip-gween" {
+  allow_list_name        = "device-ip-gween"
+  allow_list_description = "device-ip-gween"
+  cidr_list              = ["152.165.26.42/32"]
+}
+`))
+			data, err = os.ReadFile(filepath.Join(dir, "vpcs.tf"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`resource "ybm_vpc" "gwenn-gcp-jp3" {
+  name  = "gwenn-gcp-jp3"
+  cloud = "GCP"
+  global_cidr = "10.10.0.0/16"
+}
+`))
+			data, err = os.ReadFile(filepath.Join(dir, "imports.tf"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`import {
+  to = ybm_allow_list.device-ip-gween
+  id = "1eaf5552-e2f3-4a28-b215-106667c05178"
+}
+`))
+			Expect(filepath.Join(dir, "provider.tf")).To(BeAnExistingFile())
+			Expect(filepath.Join(dir, "clusters.tf")).ToNot(BeAnExistingFile())
+			session.Kill()
+		})
+
+		It("should write the clusters with placeholders for their credentials and their metrics exporter", func() {
+			projectPath := "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/projects/78d4459c-0f45-47a5-899a-45ddf43eba6e"
+			clusters, err := os.ReadFile("./test/fixtures/list-clusters.json")
+			Expect(err).ToNot(HaveOccurred())
+			// The release track of the cluster is left out, so that its name is not looked up
+			server.RouteToHandler(http.MethodGet, projectPath+"/clusters",
+				ghttp.RespondWith(http.StatusOK, strings.ReplaceAll(string(clusters), `"6981a29d-8bce-45a7-ba95-efc7d3eeff84"`, "null")))
+			server.RouteToHandler(http.MethodGet, projectPath+"/clusters/5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8/allow-lists",
+				ghttp.RespondWithJSONEncodedPtr(&statusCode, responseNAL))
+			metricsExporters, err := os.ReadFile("./test/fixtures/list-metrics-exporter.json")
+			Expect(err).ToNot(HaveOccurred())
+			server.RouteToHandler(http.MethodGet, projectPath+"/metrics-exporter-configs",
+				ghttp.RespondWith(http.StatusOK, strings.Replace(string(metricsExporters), `"cluster_ids": []`, `"cluster_ids": ["5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"]`, 1)))
+
+			cmd := exec.Command(compiledCLIPath, "export", "--dir", dir)
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(5)
+			data, err := os.ReadFile(filepath.Join(dir, "clusters", "stunning-sole.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`  credentials:
+    username: ${STUNNING_SOLE_USERNAME}
+    password: ${STUNNING_SOLE_PASSWORD}
+`))
+			Expect(string(data)).To(ContainSubstring(`kind: MetricsExporter
+spec:
+  cluster: stunning-sole
+  settings:
+    exporter: ff
+`))
+			session.Kill()
+		})
+	})
+
+	AfterEach(func() {
+		os.Args = args
+		server.Close()
+	})
+})
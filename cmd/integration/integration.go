@@ -74,7 +74,7 @@ var createIntegrationCmd = &cobra.Command{
 
 		msg := fmt.Sprintf("The Integration %s has been created", formatter.Colorize(IntegrationName, formatter.GREEN_COLOR))
 
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		IntegrationCtx := formatter.Context{
 			Output: os.Stdout,
@@ -111,7 +111,7 @@ var listIntegrationCmd = &cobra.Command{
 		}
 
 		if len(resp.GetData()) < 1 {
-			fmt.Println("No Integrations found")
+			fmt.Fprintln(formatter.StatusOutput(), "No Integrations found")
 			return
 		}
 
@@ -151,7 +151,7 @@ var deleteIntegrationCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The Integration %s has been deleted\n", formatter.Colorize(configName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The Integration %s has been deleted\n", formatter.Colorize(configName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -193,21 +193,71 @@ func init() {
 	IntegrationCmd.AddCommand(deleteIntegrationCmd)
 	deleteIntegrationCmd.Flags().String("config-name", "", "[REQUIRED] The name of the Integration")
 	deleteIntegrationCmd.MarkFlagRequired("config-name")
+	ybmAuthClient.AddIdFlag(deleteIntegrationCmd, deleteIntegrationCmd.Flags(), "config-name", "config-id", "integration")
 	deleteIntegrationCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
 
 func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sinkTypeEnum ybmclient.TelemetryProviderTypeEnum) (*ybmclient.TelemetryProviderSpec, error) {
-	// We initialize this one here, even if we error out later
-	IntegrationSpec := ybmclient.NewTelemetryProviderSpec(IntegrationName, sinkTypeEnum)
-
+	var config map[string]string
 	switch sinkTypeEnum {
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_DATADOG:
 		if !cmd.Flags().Changed("datadog-spec") {
 			return nil, fmt.Errorf("datadog-spec is required for datadog sink")
 		}
-		datadogSpecString, _ := cmd.Flags().GetStringToString("datadog-spec")
-		apiKey := datadogSpecString["api-key"]
-		site := datadogSpecString["site"]
+		config, _ = cmd.Flags().GetStringToString("datadog-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_PROMETHEUS:
+		if !cmd.Flags().Changed("prometheus-spec") {
+			return nil, fmt.Errorf("prometheus-spec is required for prometheus sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("prometheus-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_VICTORIAMETRICS:
+		if !cmd.Flags().Changed("victoriametrics-spec") {
+			return nil, fmt.Errorf("victoriametrics-spec is required for victoriametrics sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("victoriametrics-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_GRAFANA:
+		if !cmd.Flags().Changed("grafana-spec") {
+			return nil, fmt.Errorf("grafana-spec is required for grafana sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("grafana-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_SUMOLOGIC:
+		if !cmd.Flags().Changed("sumologic-spec") {
+			return nil, fmt.Errorf("sumologic-spec is required for sumologic sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("sumologic-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_GOOGLECLOUD:
+		if !cmd.Flags().Changed("googlecloud-cred-filepath") {
+			return nil, fmt.Errorf("googlecloud-cred-filepath is required for googlecloud sink")
+		}
+		filepath, _ := cmd.Flags().GetString("googlecloud-cred-filepath")
+		config = map[string]string{"cred-filepath": filepath}
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_NEWRELIC:
+		if !cmd.Flags().Changed("newrelic-spec") {
+			return nil, fmt.Errorf("newrelic-spec is required for newrelic sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("newrelic-spec")
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_AWS_S3:
+		if !util.IsFeatureFlagEnabled(util.S3_INTEGRATION) {
+			return nil, fmt.Errorf("s3 integration is not enabled")
+		}
+		if !cmd.Flags().Changed("s3-spec") {
+			return nil, fmt.Errorf("s3-spec is required for s3 sink")
+		}
+		config, _ = cmd.Flags().GetStringToString("s3-spec")
+	}
+	return NewIntegrationSpec(IntegrationName, sinkTypeEnum, config)
+}
+
+// NewIntegrationSpec returns the spec of an integration from the keys of its
+// --<type>-spec flag, or the cred-filepath of a googlecloud integration
+func NewIntegrationSpec(IntegrationName string, sinkTypeEnum ybmclient.TelemetryProviderTypeEnum, config map[string]string) (*ybmclient.TelemetryProviderSpec, error) {
+	// We initialize this one here, even if we error out later
+	IntegrationSpec := ybmclient.NewTelemetryProviderSpec(IntegrationName, sinkTypeEnum)
+
+	switch sinkTypeEnum {
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_DATADOG:
+		apiKey := config["api-key"]
+		site := config["site"]
 		if len(apiKey) < 1 {
 			return nil, fmt.Errorf("api-key is a required field for datadog-spec")
 		}
@@ -217,36 +267,24 @@ func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sin
 		datadogSpec := ybmclient.NewDatadogTelemetryProviderSpec(apiKey, site)
 		IntegrationSpec.SetDatadogSpec(*datadogSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_PROMETHEUS:
-		if !cmd.Flags().Changed("prometheus-spec") {
-			return nil, fmt.Errorf("prometheus-spec is required for prometheus sink")
-		}
-		prometheusSpecs, _ := cmd.Flags().GetStringToString("prometheus-spec")
-		endpoint := prometheusSpecs["endpoint"]
+		endpoint := config["endpoint"]
 		if len(endpoint) < 1 {
 			return nil, fmt.Errorf("endpoint is a required field for prometheus-spec")
 		}
 		prometheusSpec := ybmclient.NewPrometheusTelemetryProviderSpec(endpoint)
 		IntegrationSpec.SetPrometheusSpec(*prometheusSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_VICTORIAMETRICS:
-		if !cmd.Flags().Changed("victoriametrics-spec") {
-			return nil, fmt.Errorf("victoriametrics-spec is required for victoriametrics sink")
-		}
-		victoriametricsSpecs, _ := cmd.Flags().GetStringToString("victoriametrics-spec")
-		endpoint := victoriametricsSpecs["endpoint"]
+		endpoint := config["endpoint"]
 		if len(endpoint) < 1 {
 			return nil, fmt.Errorf("endpoint is a required field for victoriametrics-spec")
 		}
 		victoriametricsSpec := ybmclient.NewVictoriaMetricsTelemetryProviderSpec(endpoint)
 		IntegrationSpec.SetVictoriametricsSpec(*victoriametricsSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_GRAFANA:
-		if !cmd.Flags().Changed("grafana-spec") {
-			return nil, fmt.Errorf("grafana-spec is required for grafana sink")
-		}
-		grafanaSpecString, _ := cmd.Flags().GetStringToString("grafana-spec")
-		apiKey := grafanaSpecString["access-policy-token"]
-		zone := grafanaSpecString["zone"]
-		instanceId := grafanaSpecString["instance-id"]
-		orgSlug := grafanaSpecString["org-slug"]
+		apiKey := config["access-policy-token"]
+		zone := config["zone"]
+		instanceId := config["instance-id"]
+		orgSlug := config["org-slug"]
 		if len(apiKey) < 1 {
 			return nil, fmt.Errorf("access-policy-token is a required field for grafana-spec")
 		}
@@ -263,13 +301,9 @@ func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sin
 		grafanaSpec := ybmclient.NewGrafanaTelemetryProviderSpec(apiKey, zone, instanceId, orgSlug)
 		IntegrationSpec.SetGrafanaSpec(*grafanaSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_SUMOLOGIC:
-		if !cmd.Flags().Changed("sumologic-spec") {
-			return nil, fmt.Errorf("sumologic-spec is required for sumologic sink")
-		}
-		sumoLogicSpecString, _ := cmd.Flags().GetStringToString("sumologic-spec")
-		accessKey := sumoLogicSpecString["access-key"]
-		accessId := sumoLogicSpecString["access-id"]
-		installationToken := sumoLogicSpecString["installation-token"]
+		accessKey := config["access-key"]
+		accessId := config["access-id"]
+		installationToken := config["installation-token"]
 		if len(accessKey) < 1 {
 			return nil, fmt.Errorf("access-key is a required field for sumologic-spec")
 		}
@@ -282,10 +316,10 @@ func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sin
 		sumoLogicSpec := ybmclient.NewSumologicTelemetryProviderSpec(installationToken, accessId, accessKey)
 		IntegrationSpec.SetSumologicSpec(*sumoLogicSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_GOOGLECLOUD:
-		if !cmd.Flags().Changed("googlecloud-cred-filepath") {
-			return nil, fmt.Errorf("googlecloud-cred-filepath is required for googlecloud sink")
+		filepath := config["cred-filepath"]
+		if len(filepath) < 1 {
+			return nil, fmt.Errorf("cred-filepath is a required field for googlecloud")
 		}
-		filepath, _ := cmd.Flags().GetString("googlecloud-cred-filepath")
 		jsonFile, err := os.Open(filepath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to open file: %s", err)
@@ -353,12 +387,8 @@ func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sin
 		}
 		IntegrationSpec.SetGooglecloudSpec(*googlecloudSpec)
 	case ybmclient.TELEMETRYPROVIDERTYPEENUM_NEWRELIC:
-		if !cmd.Flags().Changed("newrelic-spec") {
-			return nil, fmt.Errorf("newrelic-spec is required for newrelic sink")
-		}
-		newrelicSpecString, _ := cmd.Flags().GetStringToString("newrelic-spec")
-		endpoint := newrelicSpecString["endpoint"]
-		licenseKey := newrelicSpecString["license-key"]
+		endpoint := config["endpoint"]
+		licenseKey := config["license-key"]
 		if len(endpoint) < 1 {
 			return nil, fmt.Errorf("endpoint is a required field for newrelic-spec")
 		}
@@ -371,17 +401,13 @@ func setIntegrationConfiguration(cmd *cobra.Command, IntegrationName string, sin
 		if !util.IsFeatureFlagEnabled(util.S3_INTEGRATION) {
 			return nil, fmt.Errorf("s3 integration is not enabled")
 		}
-		if !cmd.Flags().Changed("s3-spec") {
-			return nil, fmt.Errorf("s3-spec is required for s3 sink")
-		}
-		s3SpecString, _ := cmd.Flags().GetStringToString("s3-spec")
-		bucket := s3SpecString["bucket"]
-		region := s3SpecString["region"]
-		accessKeyId := s3SpecString["access-key-id"]
-		secretAccessKey := s3SpecString["secret-access-key"]
-		pathPrefix := s3SpecString["path-prefix"]
-		filePrefix := s3SpecString["file-prefix"]
-		partitionStrategy := s3SpecString["partition-strategy"]
+		bucket := config["bucket"]
+		region := config["region"]
+		accessKeyId := config["access-key-id"]
+		secretAccessKey := config["secret-access-key"]
+		pathPrefix := config["path-prefix"]
+		filePrefix := config["file-prefix"]
+		partitionStrategy := config["partition-strategy"]
 		if len(bucket) < 1 {
 			return nil, fmt.Errorf("bucket is a required field for s3-spec")
 		}
@@ -66,8 +66,8 @@ var _ = Describe("Integration", func() {
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration test has been created
-Name      Type      Site      ApiKey
+			Expect(session.Err).Should(gbytes.Say(`The Integration test has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type      Site      ApiKey
 ff        DATADOG   test      c4XXXXXXXXXXXXXXXXXXXXXXXXXXXX3d`))
 			session.Kill()
 		})
@@ -105,8 +105,8 @@ ff        DATADOG   test      c4XXXXXXXXXXXXXXXXXXXXXXXXXXXX3d`))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration test has been created
-Name      Type         Endpoint
+			Expect(session.Err).Should(gbytes.Say(`The Integration test has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type         Endpoint
 test      PROMETHEUS   http://prometheus.yourcompany.com/api/v1/otlp`))
 			session.Kill()
 		})
@@ -144,8 +144,8 @@ test      PROMETHEUS   http://prometheus.yourcompany.com/api/v1/otlp`))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration test has been created
-Name      Type              Endpoint
+			Expect(session.Err).Should(gbytes.Say(`The Integration test has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type              Endpoint
 test      VICTORIAMETRICS   http://victoriametrics.yourcompany.com`))
 			session.Kill()
 		})
@@ -183,8 +183,8 @@ test      VICTORIAMETRICS   http://victoriametrics.yourcompany.com`))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration test has been created
-Name      Type      Zone        Access Token Policy                InstanceId   OrgSlug
+			Expect(session.Err).Should(gbytes.Say(`The Integration test has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type      Zone        Access Token Policy                InstanceId   OrgSlug
 grafana   GRAFANA   test-zone   glXXXXXXXXXX...XXXXXXXXXXXXXXX==   1234456      ybmclitest`))
 			session.Kill()
 		})
@@ -242,8 +242,8 @@ gwenn-sumo   SUMOLOGIC   FqXXXXXXXXXX...XXXXXXXXXXXXXXX9p   suXXXXXXXXXXJ9   U1X
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration testgcp has been created
-Name      Type
+			Expect(session.Err).Should(gbytes.Say(`The Integration testgcp has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type
 ddd       GOOGLECLOUD`))
 			session.Kill()
 		})
@@ -261,8 +261,8 @@ ddd       GOOGLECLOUD`))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The Integration testgcp2 has been created
-Name      Type
+			Expect(session.Err).Should(gbytes.Say(`The Integration testgcp2 has been created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type
 ddd       GOOGLECLOUD`))
 			session.Kill()
 		})
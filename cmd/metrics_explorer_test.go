@@ -66,8 +66,8 @@ var _ = Describe("Metrics Exporter", func() {
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The metrics exporter config 9e3fabbc-849c-4a77-bdb2-9422e712e7dc is being created
-Name      Type      Site      ApiKey
+			Expect(session.Err).Should(gbytes.Say(`The metrics exporter config 9e3fabbc-849c-4a77-bdb2-9422e712e7dc is being created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type      Site      ApiKey
 ff        DATADOG   test      c4XXXXXXXXXXXXXXXXXXXXXXXXXXXX3d`))
 			session.Kill()
 		})
@@ -105,8 +105,8 @@ ff        DATADOG   test      c4XXXXXXXXXXXXXXXXXXXXXXXXXXXX3d`))
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The metrics exporter config 92ceaa26-bac7-4842-9b3c-831a18a4f813 is being created
-Name      Type      Zone        Access Token Policy                InstanceId   OrgSlug
+			Expect(session.Err).Should(gbytes.Say(`The metrics exporter config 92ceaa26-bac7-4842-9b3c-831a18a4f813 is being created`))
+			Expect(session.Out).Should(gbytes.Say(`Name      Type      Zone        Access Token Policy                InstanceId   OrgSlug
 grafana   GRAFANA   test-zone   glXXXXXXXXXX...XXXXXXXXXXXXXXX==   1234456      ybmclitest`))
 			session.Kill()
 		})
@@ -75,7 +75,7 @@ var createMetricsExporterCmd = &cobra.Command{
 
 		msg := fmt.Sprintf("The metrics exporter config %s is being created", formatter.Colorize(metricsExporterId, formatter.GREEN_COLOR))
 
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		metricsExporterCtx := formatter.Context{
 			Output: os.Stdout,
@@ -114,7 +114,7 @@ var listMetricsExporterCmd = &cobra.Command{
 		}
 
 		if len(resp.GetData()) < 1 {
-			fmt.Println("No metrics exporters found")
+			fmt.Fprintln(formatter.StatusOutput(), "No metrics exporters found")
 			return
 		}
 
@@ -182,7 +182,7 @@ var deleteMetricsExporterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Deleting Metrics Exporter Config %s\n", formatter.Colorize(configName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Deleting Metrics Exporter Config %s\n", formatter.Colorize(configName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -211,7 +211,7 @@ var removeMetricsExporterFromClusterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Unassigning associated Metrics Exporter Config from cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Unassigning associated Metrics Exporter Config from cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -249,7 +249,7 @@ var associateMetricsExporterWithClusterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Assigning Metrics Exporter Config %s with cluster %s\n", formatter.Colorize(configName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Assigning Metrics Exporter Config %s with cluster %s\n", formatter.Colorize(configName, formatter.GREEN_COLOR), formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -277,7 +277,7 @@ var stopMetricsExporterCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("Stopping Metrics Exporter for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "Stopping Metrics Exporter for cluster %s\n", formatter.Colorize(clusterName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -326,7 +326,7 @@ var updateMetricsExporterCmd = &cobra.Command{
 
 		msg := fmt.Sprintf("The metrics exporter config %s is being updated", formatter.Colorize(config.GetInfo().Id, formatter.GREEN_COLOR))
 
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 
 		metricsExporterCtx := formatter.Context{
 			Output: os.Stdout,
@@ -361,30 +361,37 @@ func init() {
 	MetricsExporterCmd.AddCommand(describeMetricsExporterCmd)
 	describeMetricsExporterCmd.Flags().String("config-name", "", "[REQUIRED] The name of the metrics exporter configuration")
 	describeMetricsExporterCmd.MarkFlagRequired("config-name")
+	ybmAuthClient.AddIdFlag(describeMetricsExporterCmd, describeMetricsExporterCmd.Flags(), "config-name", "config-id", "metrics exporter configuration")
 
 	MetricsExporterCmd.AddCommand(deleteMetricsExporterCmd)
 	deleteMetricsExporterCmd.Flags().String("config-name", "", "[REQUIRED] The name of the metrics exporter configuration")
 	deleteMetricsExporterCmd.MarkFlagRequired("config-name")
+	ybmAuthClient.AddIdFlag(deleteMetricsExporterCmd, deleteMetricsExporterCmd.Flags(), "config-name", "config-id", "metrics exporter configuration")
 	deleteMetricsExporterCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 	MetricsExporterCmd.AddCommand(removeMetricsExporterFromClusterCmd)
 	removeMetricsExporterFromClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster")
 	removeMetricsExporterFromClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(removeMetricsExporterFromClusterCmd, removeMetricsExporterFromClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 	MetricsExporterCmd.AddCommand(associateMetricsExporterWithClusterCmd)
 	associateMetricsExporterWithClusterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster.")
 	associateMetricsExporterWithClusterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(associateMetricsExporterWithClusterCmd, associateMetricsExporterWithClusterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 	associateMetricsExporterWithClusterCmd.Flags().String("config-name", "", "[REQUIRED] The name of the metrics exporter configuration")
 	associateMetricsExporterWithClusterCmd.MarkFlagRequired("config-name")
+	ybmAuthClient.AddIdFlag(associateMetricsExporterWithClusterCmd, associateMetricsExporterWithClusterCmd.Flags(), "config-name", "config-id", "metrics exporter configuration")
 
 	MetricsExporterCmd.AddCommand(stopMetricsExporterCmd)
 	stopMetricsExporterCmd.Flags().String("cluster-name", "", "[REQUIRED] The name of the cluster.")
 	stopMetricsExporterCmd.MarkFlagRequired("cluster-name")
+	ybmAuthClient.AddIdFlag(stopMetricsExporterCmd, stopMetricsExporterCmd.Flags(), "cluster-name", "cluster-id", "cluster")
 
 	MetricsExporterCmd.AddCommand(updateMetricsExporterCmd)
 	updateMetricsExporterCmd.Flags().SortFlags = false
 	updateMetricsExporterCmd.Flags().String("config-name", "", "[REQUIRED] The name of the metrics exporter configuration")
 	updateMetricsExporterCmd.MarkFlagRequired("config-name")
+	ybmAuthClient.AddIdFlag(updateMetricsExporterCmd, updateMetricsExporterCmd.Flags(), "config-name", "config-id", "metrics exporter configuration")
 	updateMetricsExporterCmd.Flags().String("type", "", "[REQUIRED] The type of third party metrics sink")
 	updateMetricsExporterCmd.MarkFlagRequired("type")
 	updateMetricsExporterCmd.Flags().String("new-config-name", "", "[OPTIONAL] The new name of the metrics exporter configuration")
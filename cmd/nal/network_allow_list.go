@@ -112,7 +112,7 @@ var createNetworkAllowListCmd = &cobra.Command{
 
 		formatter.NetworkAllowListWrite(nalCtx, respFilter)
 
-		fmt.Printf("NetworkAllowList %s successful created\n", formatter.Colorize(nalName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "NetworkAllowList %s successful created\n", formatter.Colorize(nalName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -134,24 +134,18 @@ var deleteNetworkAllowListCmd = &cobra.Command{
 		}
 		authApi.GetInfo("", "")
 
-		resp, r, err := authApi.ListNetworkAllowLists().Execute()
-		if err != nil {
-			logrus.Debugf("Full HTTP response: %v", r)
-			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
-		}
-
-		allowList, err := util.FindNetworkAllowList(resp.Data, nalName)
+		allowListId, err := authApi.GetNetworkAllowListIdByName(nalName)
 		if err != nil {
-			logrus.Error(err)
+			logrus.Error(ybmAuthClient.GetApiErrorDetails(err))
 			return
 		}
 
-		r, err = authApi.DeleteNetworkAllowList(allowList.Info.Id).Execute()
+		r, err := authApi.DeleteNetworkAllowList(allowListId).Execute()
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
-		fmt.Printf("NetworkAllowList %s successfully deleted\n", formatter.Colorize(nalName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "NetworkAllowList %s successfully deleted\n", formatter.Colorize(nalName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -170,5 +164,6 @@ func init() {
 	NalCmd.AddCommand(deleteNetworkAllowListCmd)
 	deleteNetworkAllowListCmd.Flags().StringVarP(&nalName, "name", "n", "", "[REQUIRED] The name of the Network Allow List.")
 	deleteNetworkAllowListCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(deleteNetworkAllowListCmd, deleteNetworkAllowListCmd.Flags(), "name", "network-allow-list-id", "network allow list")
 	deleteNetworkAllowListCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
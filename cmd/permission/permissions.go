@@ -45,14 +45,12 @@ var listResourcePermissionsCmd = &cobra.Command{
 		}
 		authApi.GetInfo("", "")
 
-		resourcePermissionsResp, resp, err := authApi.ListResourcePermissions().Execute()
+		resourcePermissionData, resp, err := authApi.ListResourcePermissionsCached()
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", resp)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		resourcePermissionData := resourcePermissionsResp.Data
-
 		resourcePermissionCtx := formatter.Context{
 			Output: os.Stdout,
 			Format: formatter.NewResourcePermissionFormat(viper.GetString("output")),
@@ -111,7 +111,7 @@ test_ysql_db   YSQL         5                          QUEUED    654321
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say(`The requested PITR Configurations are being created`))
+			Expect(session.Err).Should(gbytes.Say(`The requested PITR Configurations are being created`))
 			session.Kill()
 		})
 
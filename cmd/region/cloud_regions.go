@@ -46,14 +46,12 @@ var listCloudRegionsCmd = &cobra.Command{
 		authApi.GetInfo("", "")
 
 		cloudProvider, _ := cmd.Flags().GetString("cloud-provider")
-		cloudRegionsResp, resp, err := authApi.GetSupportedCloudRegions().Cloud(cloudProvider).Execute()
+		cloudRegionData, resp, err := authApi.GetSupportedCloudRegionsCached(cloudProvider)
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", resp)
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		cloudRegionData := cloudRegionsResp.GetData()
-
 		cloudRegionCtx := formatter.Context{
 			Output: os.Stdout,
 			Format: formatter.NewCloudRegionFormat(viper.GetString("output")),
@@ -16,6 +16,7 @@
 package role
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -70,7 +71,8 @@ var listRolesCmd = &cobra.Command{
 			roleListRequest = roleListRequest.DisplayName(roleName)
 		}
 
-		roleResponse, roleResp, roleErr := roleListRequest.Execute()
+		pageOptions := ybmAuthClient.GetPageOptions(cmd)
+		roles, roleResp, roleErr := authApi.ListRbacRolesPaged(roleListRequest, pageOptions)
 
 		if roleErr != nil {
 			if strings.TrimSpace(ybmAuthClient.GetApiErrorDetails(roleErr)) == strings.TrimSpace(util.GetCustomRoleFeatureFlagDisabledError()) {
@@ -78,13 +80,13 @@ var listRolesCmd = &cobra.Command{
 				if roleName != "" {
 					systemRoleListRequest = systemRoleListRequest.DisplayName(roleName)
 				}
-				systemRoleResponse, systemRoleResp, systemRoleErr := systemRoleListRequest.Execute()
+				systemRoles, systemRoleResp, systemRoleErr := authApi.ListRbacRolesPaged(systemRoleListRequest, pageOptions)
 
 				if systemRoleErr != nil {
 					logrus.Debugf("Full HTTP response: %v", systemRoleResp)
 					logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(systemRoleErr))
 				} else {
-					roleResponse = systemRoleResponse
+					roles = systemRoles
 				}
 			} else {
 				logrus.Debugf("Full HTTP response: %v", roleResp)
@@ -96,11 +98,11 @@ var listRolesCmd = &cobra.Command{
 			Output: os.Stdout,
 			Format: formatter.NewRoleFormat(viper.GetString("output")),
 		}
-		if len(roleResponse.GetData()) < 1 {
-			fmt.Println("No roles found")
+		if len(roles) < 1 {
+			fmt.Fprintln(formatter.StatusOutput(), "No roles found")
 			return
 		}
-		formatter.RoleWrite(rolesCtx, roleResponse.GetData())
+		formatter.RoleWrite(rolesCtx, roles)
 	},
 }
 
@@ -124,6 +126,11 @@ var describeRoleCmd = &cobra.Command{
 
 		roleListRequest := authApi.ListAllRbacRolesWithPermissions()
 		roleName, _ := cmd.Flags().GetString("role-name")
+		if ybmAuthClient.IsId(roleName) {
+			if role, err := authApi.GetRoleByName(roleName); err == nil {
+				roleName = role.Info.GetDisplayName()
+			}
+		}
 		roleListRequest = roleListRequest.DisplayName(roleName)
 
 		roleResponse, roleResp, roleErr := roleListRequest.Execute()
@@ -147,7 +154,12 @@ var describeRoleCmd = &cobra.Command{
 		}
 
 		if len(roleResponse.GetData()) < 1 {
-			fmt.Println("No role found")
+			didYouMean := ""
+			var notFound *ybmAuthClient.NotFoundError
+			if _, err := authApi.GetRoleByName(roleName); errors.As(err, &notFound) {
+				didYouMean = notFound.DidYouMean()
+			}
+			fmt.Fprintln(formatter.StatusOutput(), "No role found"+didYouMean)
 			return
 		}
 
@@ -375,7 +387,7 @@ var deleteRoleCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The role %s has been successfully deleted.\n", formatter.Colorize(roleName, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The role %s has been successfully deleted.\n", formatter.Colorize(roleName, formatter.GREEN_COLOR))
 	},
 }
 
@@ -384,11 +396,13 @@ func init() {
 	listRolesCmd.Flags().SortFlags = false
 	listRolesCmd.Flags().String("role-name", "", "[OPTIONAL] To filter by role name.")
 	listRolesCmd.Flags().String("type", "", "[OPTIONAL] To filter by role type. BUILT-IN and CUSTOM options are available to list only built-in or custom roles.")
+	ybmAuthClient.AddPaginationFlags(listRolesCmd)
 
 	RoleCmd.AddCommand(describeRoleCmd)
 	describeRoleCmd.Flags().SortFlags = false
 	describeRoleCmd.Flags().String("role-name", "", "[REQUIRED] The name of the role.")
 	describeRoleCmd.MarkFlagRequired("role-name")
+	ybmAuthClient.AddIdFlag(describeRoleCmd, describeRoleCmd.Flags(), "role-name", "role-id", "role")
 
 	RoleCmd.AddCommand(createRoleCmd)
 	createRoleCmd.Flags().SortFlags = false
@@ -403,6 +417,7 @@ func init() {
 	updateRoleCmd.Flags().SortFlags = false
 	updateRoleCmd.Flags().String("role-name", "", "[REQUIRED] Name of the role.")
 	updateRoleCmd.MarkFlagRequired("role-name")
+	ybmAuthClient.AddIdFlag(updateRoleCmd, updateRoleCmd.Flags(), "role-name", "role-id", "role")
 	updateRoleCmd.Flags().StringArray("permissions", []string{}, `[REQUIRED] Permissions for the role. Please provide key value pairs resource-type=<resource-type>,operation-group=<operation-group> as the value. Both resource-type and operation-group are mandatory. Information about multiple permissions can be specified by using multiple --permissions arguments.`)
 	updateRoleCmd.MarkFlagRequired("permissions")
 	updateRoleCmd.Flags().String("description", "", "[OPTIONAL] New description of the role to be updated.")
@@ -413,6 +428,7 @@ func init() {
 	deleteRoleCmd.Flags().SortFlags = false
 	deleteRoleCmd.Flags().String("role-name", "", "[REQUIRED] The name of the role to be deleted.")
 	deleteRoleCmd.MarkFlagRequired("role-name")
+	ybmAuthClient.AddIdFlag(deleteRoleCmd, deleteRoleCmd.Flags(), "role-name", "role-id", "role")
 	deleteRoleCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 }
@@ -16,6 +16,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -25,11 +26,16 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/yugabyte/ybm-cli/cmd/api_key"
+	"github.com/yugabyte/ybm-cli/cmd/apply"
 	"github.com/yugabyte/ybm-cli/cmd/backup"
 	"github.com/yugabyte/ybm-cli/cmd/billing"
+	"github.com/yugabyte/ybm-cli/cmd/cache"
 	"github.com/yugabyte/ybm-cli/cmd/cdc"
 	"github.com/yugabyte/ybm-cli/cmd/cluster"
+	"github.com/yugabyte/ybm-cli/cmd/diff"
 	"github.com/yugabyte/ybm-cli/cmd/dr"
+	"github.com/yugabyte/ybm-cli/cmd/drift"
+	"github.com/yugabyte/ybm-cli/cmd/export"
 	"github.com/yugabyte/ybm-cli/cmd/integration"
 	"github.com/yugabyte/ybm-cli/cmd/metrics_exporter"
 	"github.com/yugabyte/ybm-cli/cmd/nal"
@@ -37,13 +43,16 @@ import (
 	"github.com/yugabyte/ybm-cli/cmd/region"
 	"github.com/yugabyte/ybm-cli/cmd/role"
 	"github.com/yugabyte/ybm-cli/cmd/signup"
+	"github.com/yugabyte/ybm-cli/cmd/task"
 	"github.com/yugabyte/ybm-cli/cmd/tools"
 	"github.com/yugabyte/ybm-cli/cmd/usage"
 	"github.com/yugabyte/ybm-cli/cmd/user"
 	"github.com/yugabyte/ybm-cli/cmd/util"
 	"github.com/yugabyte/ybm-cli/cmd/vpc"
 
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	"github.com/yugabyte/ybm-cli/internal/log"
+	"github.com/yugabyte/ybm-cli/internal/notify"
 	"github.com/yugabyte/ybm-cli/internal/releases"
 )
 
@@ -64,11 +73,35 @@ var rootCmd = &cobra.Command{
 		cmd.Help()
 	},
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		if strings.HasPrefix(cmd.CommandPath(), "ybm completion") {
+		if strings.HasPrefix(cmd.CommandPath(), "ybm completion") || cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
 			return
 		}
 		releases.PrintUpgradeMessageIfNeeded()
 
+		if err := ybmAuthClient.ApplyIdFlags(cmd); err != nil {
+			logrus.Fatal(err)
+		}
+		if cmd.Flags().Changed("notify") {
+			targets, _ := cmd.Flags().GetStringArray("notify")
+			viper.Set("notify", targets)
+		}
+		if _, err := notify.ParseTargets(ybmAuthClient.NotifyTargets(), nil); err != nil {
+			logrus.Fatal(err)
+		}
+		if progress := viper.GetString("progress"); progress != "text" && progress != "json" {
+			logrus.Fatalf("--progress must be text or json, got '%s'", progress)
+		}
+		if viper.GetDuration("poll-interval") <= 0 {
+			logrus.Fatalf("--poll-interval must be positive, got %s", viper.GetDuration("poll-interval"))
+		}
+		// No task is started with --dry-run, there is nothing to wait for
+		if ybmAuthClient.DryRun() {
+			viper.Set("wait", false)
+		}
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		ybmAuthClient.EndDryRun()
+		ybmAuthClient.CloseTraceRecorder()
 	},
 }
 
@@ -90,6 +123,16 @@ func setDefaults() {
 	viper.SetDefault("no-color", false)
 	viper.SetDefault("wait", false)
 	viper.SetDefault("timeout", time.Duration(7*24*time.Hour))
+	viper.SetDefault("poll-interval", 10*time.Second)
+	viper.SetDefault("poll-backoff", 1.0)
+	viper.SetDefault("poll-max-interval", time.Duration(0))
+	viper.SetDefault("progress", "text")
+	viper.SetDefault("insecure-skip-tls-verify", false)
+	viper.SetDefault("no-cache", false)
+	viper.SetDefault("dry-run", false)
+	viper.SetDefault("log-format", "text")
+	viper.SetDefault("log-file-max-size", 10)
+	viper.SetDefault("log-file-max-backups", 3)
 	viper.SetDefault("lastVersionAvailable", "v0.0.0")
 	viper.SetDefault("lastCheckedTime", 0)
 }
@@ -103,21 +146,50 @@ func init() {
 	setDefaults()
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.ybm-cli.yaml)")
 	rootCmd.PersistentFlags().StringP("apiKey", "a", "", "YugabyteDB Aeon account API key")
-	rootCmd.PersistentFlags().StringP("output", "o", "", "Select the desired output format (table, json, pretty). Default to table")
+	rootCmd.PersistentFlags().StringP("output", "o", "", "Select the desired output format (table, json, ndjson, pretty). Default to table")
 	rootCmd.PersistentFlags().StringP("logLevel", "l", "", "Select the desired log level format(info). Default to info")
 	rootCmd.PersistentFlags().Bool("debug", false, "Use debug mode, same as --logLevel debug")
+	rootCmd.PersistentFlags().String("log-format", "", "Select the diagnostics log format (text, json). Default to text")
+	rootCmd.PersistentFlags().String("log-file", "", "Also write diagnostics logs to this file, rotated by size")
+	rootCmd.PersistentFlags().Int("log-file-max-size", 10, "Size in MB after which the log file is rotated")
+	rootCmd.PersistentFlags().Int("log-file-max-backups", 3, "Number of rotated log files to keep")
 	rootCmd.PersistentFlags().Bool("no-color", false, "Disable colors in output , default to false")
 	rootCmd.PersistentFlags().Bool("wait", false, "Wait until the task is completed, otherwise it will exit immediately, default to false")
 	rootCmd.PersistentFlags().Duration("timeout", 7*24*time.Hour, "Wait command timeout, example: 5m, 1h.")
+	rootCmd.PersistentFlags().Duration("poll-interval", 10*time.Second, "Time between two task status requests while waiting, example: 5s, 1m.")
+	rootCmd.PersistentFlags().Float64("poll-backoff", 1, "Factor applied to the poll interval after every request while waiting, default to 1 (no backoff)")
+	rootCmd.PersistentFlags().Duration("poll-max-interval", 0, "Maximum poll interval reached with --poll-backoff, default to no maximum")
+	rootCmd.PersistentFlags().StringArray("notify", []string{}, "Notify a target when a waited operation completes, whatever the outcome: webhook=<url> (JSON POST), slack=<url> (Slack incoming webhook) or exec=<command> (event as JSON on stdin). Can be repeated")
+	rootCmd.PersistentFlags().String("progress", "", "Select how waiting reports progress (text, json). json writes one event per line to stderr on every state or action change. Default to text")
+	rootCmd.PersistentFlags().String("proxy", "", "HTTPS proxy used for every request, example: http://proxy.corp:3128. Default to the HTTPS_PROXY environment variable")
+	rootCmd.PersistentFlags().String("ca-bundle", "", "Path to a PEM file with additional CA certificates to trust, e.g. for a TLS-intercepting proxy")
+	rootCmd.PersistentFlags().Bool("insecure-skip-tls-verify", false, "Skip TLS certificate verification. INSECURE, only use it against lab hosts, default to false")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Do not read or write the local cache of regions, node configurations, tracks and permissions, default to false")
+	rootCmd.PersistentFlags().Bool("dry-run", false, "Print the requests a create, update, delete, pause, resume, restore or assign command would send, as redacted JSON, without sending them or waiting. Names are still resolved to IDs, the IDs of resources the command would create are left empty, default to false")
+	rootCmd.PersistentFlags().String("trace-file", "", "Record every API request and response (redacted) to this file. HAR format if the file ends with .har, JSON lines otherwise")
 
 	//Bind peristents flags to viper
 	viper.BindPFlag("apiKey", rootCmd.PersistentFlags().Lookup("apiKey"))
 	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
 	viper.BindPFlag("logLevel", rootCmd.PersistentFlags().Lookup("logLevel"))
 	viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
+	viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
+	viper.BindPFlag("log-file-max-size", rootCmd.PersistentFlags().Lookup("log-file-max-size"))
+	viper.BindPFlag("log-file-max-backups", rootCmd.PersistentFlags().Lookup("log-file-max-backups"))
 	viper.BindPFlag("no-color", rootCmd.PersistentFlags().Lookup("no-color"))
 	viper.BindPFlag("wait", rootCmd.PersistentFlags().Lookup("wait"))
 	viper.BindPFlag("timeout", rootCmd.PersistentFlags().Lookup("timeout"))
+	viper.BindPFlag("poll-interval", rootCmd.PersistentFlags().Lookup("poll-interval"))
+	viper.BindPFlag("poll-backoff", rootCmd.PersistentFlags().Lookup("poll-backoff"))
+	viper.BindPFlag("poll-max-interval", rootCmd.PersistentFlags().Lookup("poll-max-interval"))
+	viper.BindPFlag("progress", rootCmd.PersistentFlags().Lookup("progress"))
+	viper.BindPFlag("proxy", rootCmd.PersistentFlags().Lookup("proxy"))
+	viper.BindPFlag("ca-bundle", rootCmd.PersistentFlags().Lookup("ca-bundle"))
+	viper.BindPFlag("insecure-skip-tls-verify", rootCmd.PersistentFlags().Lookup("insecure-skip-tls-verify"))
+	viper.BindPFlag("trace-file", rootCmd.PersistentFlags().Lookup("trace-file"))
+	viper.BindPFlag("no-cache", rootCmd.PersistentFlags().Lookup("no-cache"))
+	viper.BindPFlag("dry-run", rootCmd.PersistentFlags().Lookup("dry-run"))
 
 	// Make host configurable only if the CONFIGURE_URL feature flag is set to true
 	if util.IsFeatureFlagEnabled(util.CONFIGURE_URL) {
@@ -139,11 +211,18 @@ func init() {
 	rootCmd.AddCommand(user.UserCmd)
 	rootCmd.AddCommand(metrics_exporter.MetricsExporterCmd)
 	rootCmd.AddCommand(integration.IntegrationCmd)
+	rootCmd.AddCommand(cache.CacheCmd)
+	rootCmd.AddCommand(task.TaskCmd)
+	rootCmd.AddCommand(apply.ApplyCmd)
+	rootCmd.AddCommand(diff.DiffCmd)
+	rootCmd.AddCommand(export.ExportCmd)
+	rootCmd.AddCommand(drift.DriftCmd)
 	util.AddCommandIfFeatureFlag(rootCmd, billing.BillingCmd, util.BILLING)
 	util.AddCommandIfFeatureFlag(rootCmd, dr.DrCmd, util.DR)
 	util.AddCommandIfFeatureFlag(rootCmd, tools.ToolsCmd, util.TOOLS)
 	util.AddCommandIfFeatureFlag(rootCmd, cdc.CdcCmd, util.CDC)
 
+	ybmAuthClient.RegisterCompletions(rootCmd)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -164,14 +243,26 @@ func initConfig() {
 
 	//Will check every environment variable starting with YBM_
 	viper.SetEnvPrefix("ybm")
+	//Dashed settings are read from underscored variables, e.g. YBM_NO_CACHE
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	//Read all enviromnent variable that match YBM_ENVNAME
 	viper.AutomaticEnv() // read in environment variables that match
+	// If a config file is found, read it in.
+	// Logging is configured afterwards so that log settings from the file apply.
+	configErr := viper.ReadInConfig()
+	if err := log.ValidateLogFormat(viper.GetString("log-format")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := log.SetOutput(viper.GetString("log-file"), viper.GetInt("log-file-max-size"), viper.GetInt("log-file-max-backups")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	//Set Logrus formatter options
 	log.SetFormatter()
 	// Set log level
 	log.SetLogLevel(viper.GetString("logLevel"), viper.GetBool("debug"))
-	// If a config file is found, read it in.
-	if err := viper.ReadInConfig(); err == nil {
+	if configErr == nil {
 		logrus.Debugf("Using config file: %s", viper.ConfigFileUsed())
 	}
 
@@ -0,0 +1,212 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package task
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/internal/watch"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var TaskCmd = &cobra.Command{
+	Use:   "task",
+	Short: "Manage tasks",
+	Long:  "List and inspect the tasks run by YugabyteDB Aeon, such as cluster edits, backups and restores",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var listTaskCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tasks",
+	Long:  "List the tasks of the project in YugabyteDB Aeon, most recent first",
+	Run: func(cmd *cobra.Command, args []string) {
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		filter, err := taskFilter(authApi, cmd)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		err = watch.Run(cmd, args, func(out io.Writer) error {
+			tasks, r, err := authApi.ListTasksPaged(filter, ybmAuthClient.GetPageOptions(cmd))
+			if err != nil {
+				logrus.Debugf("Full HTTP response: %v", r)
+				return fmt.Errorf("%s", ybmAuthClient.GetApiErrorDetails(err))
+			}
+			if len(tasks) == 0 {
+				fmt.Fprintln(formatter.StatusOutput(), "No tasks found")
+				return nil
+			}
+
+			tasksCtx := formatter.Context{
+				Output: out,
+				Format: formatter.NewTaskFormat(viper.GetString("output")),
+			}
+			return formatter.TaskWrite(tasksCtx, tasks)
+		})
+		if err != nil {
+			logrus.Fatal(err)
+		}
+	},
+}
+
+var describeTaskCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Describe a task",
+	Long:  "Describe a task in YugabyteDB Aeon, with the progress of each of its actions",
+	Run: func(cmd *cobra.Command, args []string) {
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		taskId, _ := cmd.Flags().GetString("task-id")
+		filter, err := taskFilter(authApi, cmd)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		task, err := authApi.GetTaskById(taskId, filter)
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+
+		if viper.GetString("output") == "table" {
+			fullTaskContext := *formatter.NewFullTaskContext()
+			fullTaskContext.Output = os.Stdout
+			fullTaskContext.Format = formatter.NewFullTaskFormat(viper.GetString("output"))
+			fullTaskContext.SetFullTask(task)
+			fullTaskContext.Write()
+			return
+		}
+
+		taskCtx := formatter.Context{
+			Output: os.Stdout,
+			Format: formatter.NewFullTaskFormat(viper.GetString("output")),
+		}
+		formatter.SingleTaskWrite(taskCtx, task)
+	},
+}
+
+// taskFilter reads the task filter flags of cmd. Entity names are resolved for
+// clusters only, other entities are given by ID.
+func taskFilter(authApi *ybmAuthClient.AuthApiClient, cmd *cobra.Command) (ybm.TaskFilter, error) {
+	filter := ybm.TaskFilter{}
+	if cmd.Flags().Changed("entity-type") {
+		entityType, _ := cmd.Flags().GetString("entity-type")
+		value, err := ybmclient.NewEntityTypeEnumFromValue(strings.ToUpper(entityType))
+		if err != nil {
+			return filter, err
+		}
+		filter.EntityType = *value
+	}
+	if cmd.Flags().Changed("task-type") {
+		taskType, _ := cmd.Flags().GetString("task-type")
+		value, err := ybmclient.NewTaskTypeEnumFromValue(strings.ToUpper(taskType))
+		if err != nil {
+			return filter, err
+		}
+		filter.TaskType = *value
+	}
+	if cmd.Flags().Changed("entity-name") {
+		entityName, _ := cmd.Flags().GetString("entity-name")
+		entityType, entityId, err := resolveEntity(authApi, filter.EntityType, entityName)
+		if err != nil {
+			return filter, err
+		}
+		filter.EntityType = entityType
+		filter.EntityID = entityId
+	}
+	if cmd.Flags().Changed("state") {
+		filter.States, _ = cmd.Flags().GetStringSlice("state")
+	}
+	if cmd.Flags().Changed("since") {
+		since, _ := cmd.Flags().GetString("since")
+		t, err := parseSince(since, time.Now())
+		if err != nil {
+			return filter, err
+		}
+		filter.Since = t
+	}
+	return filter, nil
+}
+
+// resolveEntity returns the type and ID of the entity named entityName. An empty
+// entityType is taken as a cluster.
+func resolveEntity(authApi *ybmAuthClient.AuthApiClient, entityType ybmclient.EntityTypeEnum, entityName string) (ybmclient.EntityTypeEnum, string, error) {
+	switch {
+	case entityType == "" || entityType == ybmclient.ENTITYTYPEENUM_CLUSTER:
+		clusterId, err := authApi.GetClusterIdByName(entityName)
+		if err != nil {
+			return entityType, "", err
+		}
+		return ybmclient.ENTITYTYPEENUM_CLUSTER, clusterId, nil
+	case ybmAuthClient.IsId(entityName):
+		return entityType, entityName, nil
+	default:
+		return entityType, "", fmt.Errorf("only cluster names are resolved, use the ID of the %s", strings.ToLower(string(entityType)))
+	}
+}
+
+// parseSince accepts a duration before now, such as 24h, or a date
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(since); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, since); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("--since must be a duration such as 24h or a date such as 2024-03-05, got '%s'", since)
+}
+
+func addTaskFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("entity-type", "", "[OPTIONAL] Type of the entity of the tasks, such as CLUSTER or BACKUP.")
+	cmd.Flags().String("entity-name", "", "[OPTIONAL] Name of the cluster of the tasks, or ID of another entity.")
+	ybmAuthClient.AddIdFlag(cmd, cmd.Flags(), "entity-name", "entity-id", "entity")
+	cmd.Flags().String("task-type", "", "[OPTIONAL] Type of the tasks, such as EDIT_CLUSTER or RESTORE_BACKUP.")
+}
+
+func init() {
+	TaskCmd.AddCommand(listTaskCmd)
+	addTaskFilterFlags(listTaskCmd)
+	listTaskCmd.Flags().StringSlice("state", []string{}, "[OPTIONAL] States of the tasks, such as IN_PROGRESS, SUCCEEDED or FAILED.")
+	listTaskCmd.Flags().String("since", "", "[OPTIONAL] Only list the tasks created since a duration such as 24h, or a date such as 2024-03-05.")
+	ybmAuthClient.AddPaginationFlags(listTaskCmd)
+	watch.AddFlag(listTaskCmd)
+
+	TaskCmd.AddCommand(describeTaskCmd)
+	describeTaskCmd.Flags().String("task-id", "", "[REQUIRED] The ID of the task.")
+	describeTaskCmd.MarkFlagRequired("task-id")
+	addTaskFilterFlags(describeTaskCmd)
+}
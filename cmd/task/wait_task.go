@@ -0,0 +1,103 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package task
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var waitTaskCmd = &cobra.Command{
+	Use:   "wait",
+	Short: "Wait for a task to complete",
+	Long: `Wait for a task to complete, for example after a --wait timed out or was interrupted.
+The task is given by ID, or as the latest task of a type on an entity.`,
+	Example: `ybm task wait --task-id 9ba6e8a0-b5a2-4c0f-9aa9-b5f1c2b4d3e6
+ybm task wait --entity cluster --name my-cluster --type EDIT_CLUSTER`,
+	Run: func(cmd *cobra.Command, args []string) {
+		authApi, err := ybmAuthClient.NewAuthApiClient()
+		if err != nil {
+			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+		}
+		authApi.GetInfo("", "")
+
+		filter := ybm.TaskFilter{}
+		if cmd.Flags().Changed("entity") {
+			entity, _ := cmd.Flags().GetString("entity")
+			entityType, err := ybmclient.NewEntityTypeEnumFromValue(strings.ToUpper(entity))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			filter.EntityType = *entityType
+		}
+		if cmd.Flags().Changed("type") {
+			taskTypeFlag, _ := cmd.Flags().GetString("type")
+			taskType, err := ybmclient.NewTaskTypeEnumFromValue(strings.ToUpper(taskTypeFlag))
+			if err != nil {
+				logrus.Fatal(err)
+			}
+			filter.TaskType = *taskType
+		}
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			filter.EntityType, filter.EntityID, err = resolveEntity(authApi, filter.EntityType, name)
+			if err != nil {
+				logrus.Fatal(err)
+			}
+		}
+
+		taskId, _ := cmd.Flags().GetString("task-id")
+		if taskId == "" {
+			task, err := authApi.GetLatestTask(filter.EntityID, filter.EntityType, filter.TaskType)
+			if err != nil {
+				logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
+			}
+			if task == nil {
+				logrus.Fatalf("No %s task found for %s %s", filter.TaskType, strings.ToLower(string(filter.EntityType)), filter.EntityID)
+			}
+			taskId = task.Info.GetId()
+		}
+
+		msg := fmt.Sprintf("Waiting for the task %s", formatter.Colorize(taskId, formatter.GREEN_COLOR))
+		returnStatus, err := authApi.WaitForTaskIdCompletion(taskId, filter, []string{"FAILED", "SUCCEEDED"}, msg)
+		if err != nil {
+			logrus.Fatalf("error when getting task status: %s", err)
+		}
+		if returnStatus != "SUCCEEDED" {
+			logrus.Fatalf("Operation failed with error: %s", returnStatus)
+		}
+		fmt.Fprintf(formatter.StatusOutput(), "The task %s has completed\n", formatter.Colorize(taskId, formatter.GREEN_COLOR))
+	},
+}
+
+func init() {
+	TaskCmd.AddCommand(waitTaskCmd)
+	waitTaskCmd.Flags().String("task-id", "", "[OPTIONAL] The ID of the task.")
+	waitTaskCmd.Flags().String("entity", "", "[OPTIONAL] Type of the entity of the task, such as CLUSTER or BACKUP. Defaults to CLUSTER with --name.")
+	waitTaskCmd.Flags().String("name", "", "[OPTIONAL] Name of the cluster of the task, or ID of another entity.")
+	waitTaskCmd.Flags().String("type", "", "[OPTIONAL] Type of the task, such as EDIT_CLUSTER. Required with --name.")
+	waitTaskCmd.MarkFlagsOneRequired("task-id", "name")
+	waitTaskCmd.MarkFlagsMutuallyExclusive("task-id", "name")
+	waitTaskCmd.MarkFlagsMutuallyExclusive("task-id", "type")
+	waitTaskCmd.MarkFlagsRequiredTogether("name", "type")
+}
@@ -0,0 +1,208 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	openapi "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Task", func() {
+
+	var (
+		server            *ghttp.Server
+		statusCode        int
+		args              []string
+		responseAccount   openapi.AccountResponse
+		responseProject   openapi.AccountResponse
+		responseListTasks openapi.TaskListResponse
+	)
+
+	BeforeEach(func() {
+		args = os.Args
+		os.Args = []string{}
+		var err error
+		server, err = newGhttpServer(responseAccount, responseProject)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("YBM_HOST", fmt.Sprintf("http://%s", server.Addr()))
+		os.Setenv("YBM_APIKEY", "test-token")
+
+		statusCode = 200
+		err = loadJson("./test/fixtures/list-tasks.json", &responseListTasks)
+		Expect(err).ToNot(HaveOccurred())
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks"),
+				ghttp.RespondWithJSONEncodedPtr(&statusCode, responseListTasks),
+			),
+		)
+	})
+
+	Context("When listing tasks", func() {
+		It("should list the tasks with their progress", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "list")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Out).Should(gbytes.Say(`ID\s+Type\s+Entity Type\s+Entity ID\s+State\s+Created On\s+Progress`))
+			Expect(session.Out).Should(gbytes.Say(`e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1\s+EDIT_CLUSTER\s+CLUSTER\s+5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8\s+IN_PROGRESS\s+.+\s+70%`))
+			Expect(session.Out).Should(gbytes.Say(`0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a\s+CREATE_BACKUP\s+CLUSTER\s+5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8\s+SUCCEEDED`))
+			session.Kill()
+		})
+
+		It("should filter the tasks by state", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "list", "--state", "succeeded")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Out).ShouldNot(gbytes.Say("e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1"))
+			Expect(string(session.Out.Contents())).To(ContainSubstring("0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a"))
+			session.Kill()
+		})
+
+		It("should keep listing the tasks until it finds some in the state", func() {
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("continuation_token") == "" {
+					fmt.Fprint(w, `{"data": [{"info": {"id": "e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1", "task_type": "EDIT_CLUSTER", "entity_type": "CLUSTER", "state": "IN_PROGRESS"}}], "_metadata": {"continuation_token": "page-2"}}`)
+					return
+				}
+				fmt.Fprint(w, `{"data": [{"info": {"id": "7c2d9e1f-4b3a-4e5d-8f6a-1b2c3d4e5f6a", "task_type": "CREATE_BACKUP", "entity_type": "CLUSTER", "state": "FAILED"}}], "_metadata": {}}`)
+			})
+			cmd := exec.Command(compiledCLIPath, "task", "list", "--state", "failed")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Out).Should(gbytes.Say("7c2d9e1f-4b3a-4e5d-8f6a-1b2c3d4e5f6a"))
+			Expect(string(session.Out.Contents())).ToNot(ContainSubstring("e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1"))
+			session.Kill()
+		})
+
+		It("should stop listing the tasks once they are older than the date", func() {
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks", func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.URL.Query().Get("continuation_token")).To(BeEmpty())
+				fmt.Fprint(w, `{"data": [{"info": {"id": "e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1", "task_type": "EDIT_CLUSTER", "entity_type": "CLUSTER", "state": "SUCCEEDED", "created_on": "2024-03-05T03:33:23.532Z"}}, {"info": {"id": "0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a", "task_type": "CREATE_BACKUP", "entity_type": "CLUSTER", "state": "SUCCEEDED", "created_on": "2024-03-04T20:28:32.982Z"}}], "_metadata": {"continuation_token": "page-2"}}`)
+			})
+			cmd := exec.Command(compiledCLIPath, "task", "list", "--since", "2024-03-05")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(session.Out).Should(gbytes.Say("e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1"))
+			Expect(string(session.Out.Contents())).ToNot(ContainSubstring("0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a"))
+			session.Kill()
+		})
+
+		It("should reject an unknown task type", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "list", "--task-type", "make_coffee")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say("MAKE_COFFEE"))
+			Expect(session).Should(gexec.Exit(1))
+			session.Kill()
+		})
+	})
+
+	Context("When watching tasks", func() {
+		It("should only print the records which changed when not on a terminal", func() {
+			server.RouteToHandler(http.MethodGet, "/api/public/v1/accounts/340af43a-8a7c-4659-9258-4876fd6a207b/tasks",
+				ghttp.RespondWithJSONEncodedPtr(&statusCode, &responseListTasks),
+			)
+			cmd := exec.Command(compiledCLIPath, "task", "list", "--watch=500ms", "-o", "ndjson")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			time.Sleep(1500 * time.Millisecond)
+			session.Interrupt()
+			Eventually(session).Should(gexec.Exit(0))
+			Expect(strings.Count(string(session.Out.Contents()), `"id":"e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1"`)).To(Equal(1))
+		})
+	})
+
+	Context("When describing a task", func() {
+		It("should show the progress of its actions", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "describe", "--task-id", "e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Out).Should(gbytes.Say("General"))
+			Expect(session.Out).Should(gbytes.Say(`e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1\s+EDIT_CLUSTER\s+CLUSTER`))
+			Expect(session.Out).Should(gbytes.Say("Actions"))
+			Expect(session.Out).Should(gbytes.Say(`Name\s+Completed`))
+			Expect(session.Out).Should(gbytes.Say(`Provisioning nodes\s+100%`))
+			Expect(session.Out).Should(gbytes.Say(`Configuring database\s+40%`))
+			session.Kill()
+		})
+	})
+
+	Context("When waiting for a task", func() {
+		It("should return right away when the task has completed", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "wait", "--task-id", "0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say("The task 0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a has completed"))
+			Expect(session).Should(gexec.Exit(0))
+			session.Kill()
+		})
+
+		It("should report the progress as JSON events", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "wait", "--task-id", "0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a", "--progress", "json")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say(`"task_id":"0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a","task_type":"CREATE_BACKUP","entity_type":"CLUSTER","entity_id":"5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8","state":"SUCCEEDED"`))
+			Expect(session).Should(gexec.Exit(0))
+			session.Kill()
+		})
+
+		It("should notify a webhook of the outcome", func() {
+			var notification map[string]interface{}
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/hook"),
+					ghttp.VerifyContentType("application/json"),
+					func(w http.ResponseWriter, r *http.Request) {
+						defer GinkgoRecover()
+						Expect(json.NewDecoder(r.Body).Decode(&notification)).To(Succeed())
+					},
+				),
+			)
+			cmd := exec.Command(compiledCLIPath, "task", "wait", "--task-id", "0b1f5c7d-2e4a-4a59-8d3c-7f6e5d4c3b2a", "--notify", fmt.Sprintf("webhook=http://%s/hook", server.Addr()))
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session).Should(gexec.Exit(0))
+			Expect(notification).To(HaveKeyWithValue("state", "SUCCEEDED"))
+			Expect(notification).To(HaveKeyWithValue("task_type", "CREATE_BACKUP"))
+			Expect(notification).To(HaveKeyWithValue("entity_id", "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"))
+			session.Kill()
+		})
+
+		It("should require a task type with an entity name", func() {
+			cmd := exec.Command(compiledCLIPath, "task", "wait", "--name", "stunning-sole")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			session.Wait(2)
+			Expect(session.Err).Should(gbytes.Say("if any flags in the group \\[name type\\] are set they must all be set"))
+			Expect(session).Should(gexec.Exit(1))
+			session.Kill()
+		})
+	})
+
+	AfterEach(func() {
+		os.Args = args
+		server.Close()
+	})
+})
@@ -274,7 +274,7 @@ func outputCSV(resp ybmclient.BillingUsageData, filename string, selectedCluster
 		}
 	}
 
-	fmt.Printf("CSV data written to %s\n", formatter.Colorize(filename, formatter.GREEN_COLOR))
+	fmt.Fprintf(formatter.StatusOutput(), "CSV data written to %s\n", formatter.Colorize(filename, formatter.GREEN_COLOR))
 	return nil
 }
 
@@ -295,7 +295,7 @@ func outputJSON(data interface{}, filename string) error {
 		return fmt.Errorf("failed to write JSON data to file: %v", err)
 	}
 
-	fmt.Printf("JSON data written to %s\n", formatter.Colorize(filename, formatter.GREEN_COLOR))
+	fmt.Fprintf(formatter.StatusOutput(), "JSON data written to %s\n", formatter.Colorize(filename, formatter.GREEN_COLOR))
 	return nil
 }
 
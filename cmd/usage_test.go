@@ -77,7 +77,7 @@ var _ = Describe("Spend Tracking", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(actualData).To(Equal(expectedData))
-			Expect(session.Out).Should(gbytes.Say("JSON data written to usage.json\n"))
+			Expect(session.Err).Should(gbytes.Say("JSON data written to usage.json\n"))
 			os.Remove("usage.json")
 			session.Kill()
 		})
@@ -108,7 +108,7 @@ var _ = Describe("Spend Tracking", func() {
 				"2023-08-15,'courageous-jellyfish','willing-walrus',0.000000,120.520000,0.000000,6026.000000,0.000000,0.000000,0.000000,0.000000,0.000000,1.962233,0.000000,0.000000,0.000000,0.173324,0.000018,0.119023\n"
 
 			Expect(actual).To(Equal(expected))
-			Expect(session.Out).Should(gbytes.Say("CSV data written to usage.csv\n"))
+			Expect(session.Err).Should(gbytes.Say("CSV data written to usage.csv\n"))
 			os.Remove("usage.csv")
 			session.Kill()
 		})
@@ -130,7 +130,7 @@ var _ = Describe("Spend Tracking", func() {
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say("CSV data written to usage.csv\n"))
+			Expect(session.Err).Should(gbytes.Say("CSV data written to usage.csv\n"))
 			os.Remove("usage.csv")
 			session.Kill()
 		})
@@ -208,7 +208,7 @@ var _ = Describe("Spend Tracking", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(actualData).To(Equal(expectedData))
-			Expect(session.Out).Should(gbytes.Say("JSON data written to usage.json\n"))
+			Expect(session.Err).Should(gbytes.Say("JSON data written to usage.json\n"))
 			os.Remove("usage.json")
 			session.Kill()
 		})
@@ -233,7 +233,7 @@ var _ = Describe("Spend Tracking", func() {
 			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 			session.Wait(2)
-			Expect(session.Out).Should(gbytes.Say("JSON data written to usage.json\n"))
+			Expect(session.Err).Should(gbytes.Say("JSON data written to usage.json\n"))
 			os.Remove("usage.json")
 			session.Kill()
 		})
@@ -56,7 +56,7 @@ var listUsersCmd = &cobra.Command{
 			userListRequest = userListRequest.Email(email)
 		}
 
-		resp, r, err := userListRequest.Execute()
+		users, r, err := authApi.ListAccountUsersPaged(userListRequest, ybmAuthClient.GetPageOptions(cmd))
 
 		if err != nil {
 			logrus.Debugf("Full HTTP response: %v", r)
@@ -68,12 +68,12 @@ var listUsersCmd = &cobra.Command{
 			Format: formatter.NewUserFormat(viper.GetString("output")),
 		}
 
-		if len(resp.GetData()) < 1 {
+		if len(users) < 1 {
 			logrus.Info("No users found")
 			return
 		}
 
-		formatter.UserWrite(userCtx, resp.GetData())
+		formatter.UserWrite(userCtx, users)
 	},
 }
 
@@ -121,7 +121,7 @@ var inviteUserCmd = &cobra.Command{
 		if resp.Data.GetUserList()[0].GetIsSuccessful() {
 			email := resp.Data.GetUserList()[0].GetInviteUserData().Spec.GetEmail()
 			role := resp.Data.GetUserList()[0].GetInviteUserData().Info.GetRoleList()[0].GetRoles()[0].Info.GetDisplayName()
-			fmt.Printf("The user %s has been successfully invited with role: %s.\n", formatter.Colorize(email, formatter.GREEN_COLOR), formatter.Colorize(role, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The user %s has been successfully invited with role: %s.\n", formatter.Colorize(email, formatter.GREEN_COLOR), formatter.Colorize(role, formatter.GREEN_COLOR))
 		} else {
 			logrus.Debugf("Full HTTP response: %v", r)
 			logrus.Fatalf("%s \n", resp.Data.GetUserList()[0].GetErrorMessage())
@@ -185,7 +185,7 @@ var updateUserCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The role of user %s has been successfully modified.\n", formatter.Colorize(email, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The role of user %s has been successfully modified.\n", formatter.Colorize(email, formatter.GREEN_COLOR))
 	},
 }
 
@@ -222,19 +222,21 @@ var deleteUserCmd = &cobra.Command{
 			logrus.Fatalf(ybmAuthClient.GetApiErrorDetails(err))
 		}
 
-		fmt.Printf("The user %s has been successfully deleted.\n", formatter.Colorize(email, formatter.GREEN_COLOR))
+		fmt.Fprintf(formatter.StatusOutput(), "The user %s has been successfully deleted.\n", formatter.Colorize(email, formatter.GREEN_COLOR))
 	},
 }
 
 func init() {
 	UserCmd.AddCommand(listUsersCmd)
 	listUsersCmd.Flags().String("email", "", "[OPTIONAL] To filter by user email.")
+	ybmAuthClient.AddPaginationFlags(listUsersCmd)
 
 	UserCmd.AddCommand(inviteUserCmd)
 	inviteUserCmd.Flags().String("email", "", "[REQUIRED] The email of the user to be invited.")
 	inviteUserCmd.MarkFlagRequired("email")
 	inviteUserCmd.Flags().String("role-name", "", "[REQUIRED] The name of the role to be assigned to the user.")
 	inviteUserCmd.MarkFlagRequired("role-name")
+	ybmAuthClient.AddIdFlag(inviteUserCmd, inviteUserCmd.Flags(), "role-name", "role-id", "role")
 	inviteUserCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 	UserCmd.AddCommand(updateUserCmd)
@@ -242,6 +244,7 @@ func init() {
 	updateUserCmd.MarkFlagRequired("email")
 	updateUserCmd.Flags().String("role-name", "", "[REQUIRED] The name of the role to be assigned to the user.")
 	updateUserCmd.MarkFlagRequired("role-name")
+	ybmAuthClient.AddIdFlag(updateUserCmd, updateUserCmd.Flags(), "role-name", "role-id", "role")
 	updateUserCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 
 	UserCmd.AddCommand(deleteUserCmd)
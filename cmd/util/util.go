@@ -24,7 +24,9 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
 
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -41,83 +43,7 @@ func FindNetworkAllowList(nals []ybmclient.NetworkAllowListData, name string) (y
 }
 
 func GetClusterTier(tierCli string) (string, error) {
-
-	if tierCli == "Dedicated" {
-		return "PAID", nil
-	} else if tierCli == "Sandbox" {
-		return "FREE", nil
-	}
-
-	return "", fmt.Errorf("the tier must be either 'Sandbox' or 'Dedicated'")
-}
-
-func SetPreferredRegion(clusterRegionInfo []ybmclient.ClusterRegionInfo, preferredRegion string) error {
-
-	regionFound := false
-	for _, info := range clusterRegionInfo {
-		if info.PlacementInfo.CloudInfo.GetRegion() == preferredRegion {
-			regionFound = true
-		}
-	}
-
-	if !regionFound {
-		return fmt.Errorf("the preferred region is not found in the list of regions")
-	}
-
-	for i, info := range clusterRegionInfo {
-		if info.PlacementInfo.CloudInfo.GetRegion() == preferredRegion {
-			clusterRegionInfo[i].SetIsAffinitized(true)
-		} else {
-			clusterRegionInfo[i].SetIsAffinitized(false)
-		}
-	}
-
-	return nil
-
-}
-
-func SetDefaultRegion(clusterRegionInfo []ybmclient.ClusterRegionInfo, defaultRegion string) error {
-
-	regionFound := false
-	for _, info := range clusterRegionInfo {
-		if info.PlacementInfo.CloudInfo.GetRegion() == defaultRegion {
-			regionFound = true
-		}
-	}
-
-	if !regionFound {
-		return fmt.Errorf("the default region is not found in the list of regions")
-	}
-
-	for i, info := range clusterRegionInfo {
-		if info.PlacementInfo.CloudInfo.GetRegion() == defaultRegion {
-			clusterRegionInfo[i].SetIsDefault(true)
-			break
-		}
-	}
-
-	return nil
-
-}
-
-func ValidateNumFaultsToTolerate(numFaultsToTolerate int32, faultTolerance ybmclient.ClusterFaultTolerance) (bool, error) {
-	if numFaultsToTolerate < 0 || numFaultsToTolerate > 3 {
-		return false, fmt.Errorf("number of faults to tolerate must be between 0 and 3")
-	}
-	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_NONE && numFaultsToTolerate != 0 {
-		return false, fmt.Errorf("number of faults to tolerate must be 0 for fault tolerance level 'NONE'")
-	}
-	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_NODE && numFaultsToTolerate < 1 {
-		return false, fmt.Errorf("number of faults to tolerate must be greater than 0 for fault tolerance level 'NODE'")
-	}
-	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_REGION && numFaultsToTolerate < 1 {
-		return false, fmt.Errorf("number of faults to tolerate must be greater than 0 for fault tolerance level 'REGION'")
-	}
-	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_ZONE && numFaultsToTolerate != 1 {
-		return false, fmt.Errorf("number of faults to tolerate must be 1 for fault tolerance level 'ZONE'")
-	}
-
-	return true, nil
+	return ybm.ClusterTier(tierCli)
 }
 
 func ValidateCIDR(cidr string) (bool, error) {
@@ -188,7 +114,8 @@ func SplitAndIgnoreEmpty(str string, sep string) []string {
 // this function will add an interactive comfirmation with the message provided
 func ConfirmCommand(message string, bypass bool) error {
 	errAborted := errors.New("command aborted")
-	if bypass {
+	// Nothing is changed with --dry-run, there is nothing to confirm
+	if bypass || viper.GetBool("dry-run") {
 		return nil
 	}
 	response := false
@@ -192,7 +192,7 @@ var createVpcPeeringCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The VPC Peering %s has been created\n", formatter.Colorize(vpcPeeringName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The VPC Peering %s has been created\n", formatter.Colorize(vpcPeeringName, formatter.GREEN_COLOR))
 
 			vpcPeeringResp, response, err = authApi.GetVpcPeering(vpcPeeringID).Execute()
 			if err != nil {
@@ -201,7 +201,7 @@ var createVpcPeeringCmd = &cobra.Command{
 			}
 
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 
 		vpcPeeringCtx := formatter.Context{
@@ -263,10 +263,10 @@ var deleteVpcPeeringCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("VPC peering %s has been terminated.\n", formatter.Colorize(vpcPeeringName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "VPC peering %s has been terminated.\n", formatter.Colorize(vpcPeeringName, formatter.GREEN_COLOR))
 			return
 		}
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	},
 }
 
@@ -280,6 +280,7 @@ func init() {
 	createVpcPeeringCmd.MarkFlagRequired("name")
 	createVpcPeeringCmd.Flags().String("yb-vpc-name", "", "[REQUIRED] Name of the YugabyteDB Aeon VPC.")
 	createVpcPeeringCmd.MarkFlagRequired("yb-vpc-name")
+	ybmAuthClient.AddIdFlag(createVpcPeeringCmd, createVpcPeeringCmd.Flags(), "yb-vpc-name", "yb-vpc-id", "YugabyteDB Aeon VPC")
 	createVpcPeeringCmd.Flags().String("cloud-provider", "", "[REQUIRED] Cloud of the VPC with which to peer. AWS or GCP.")
 	createVpcPeeringCmd.MarkFlagRequired("cloud-provider")
 	createVpcPeeringCmd.Flags().String("app-vpc-name", "", "[OPTIONAL] Name of the application VPC. Required for GCP. Not applicable for AWS.")
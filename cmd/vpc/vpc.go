@@ -166,7 +166,7 @@ var createVpcCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The VPC %s has been created\n", formatter.Colorize(vpcName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The VPC %s has been created\n", formatter.Colorize(vpcName, formatter.GREEN_COLOR))
 
 			vpcListRequest := authApi.ListSingleTenantVpcsByName(vpcName)
 			respC, r, err := vpcListRequest.Execute()
@@ -176,7 +176,7 @@ var createVpcCmd = &cobra.Command{
 			}
 			vpcData = respC.GetData()
 		} else {
-			fmt.Println(msg)
+			fmt.Fprintln(formatter.StatusOutput(), msg)
 		}
 		vpcCtx := formatter.Context{
 			Output: os.Stdout,
@@ -229,10 +229,10 @@ var deleteVpcCmd = &cobra.Command{
 			if returnStatus != "SUCCEEDED" {
 				logrus.Fatalf("Operation failed with error: %s", returnStatus)
 			}
-			fmt.Printf("The VPC %s has been deleted\n", formatter.Colorize(vpcName, formatter.GREEN_COLOR))
+			fmt.Fprintf(formatter.StatusOutput(), "The VPC %s has been deleted\n", formatter.Colorize(vpcName, formatter.GREEN_COLOR))
 			return
 		}
-		fmt.Println(msg)
+		fmt.Fprintln(formatter.StatusOutput(), msg)
 	},
 }
 
@@ -256,5 +256,6 @@ func init() {
 	VPCCmd.AddCommand(deleteVpcCmd)
 	deleteVpcCmd.Flags().String("name", "", "[REQUIRED] Name for the VPC.")
 	deleteVpcCmd.MarkFlagRequired("name")
+	ybmAuthClient.AddIdFlag(deleteVpcCmd, deleteVpcCmd.Flags(), "name", "vpc-id", "VPC")
 	deleteVpcCmd.Flags().BoolP("force", "f", false, "Bypass the prompt for non-interactive usage")
 }
@@ -0,0 +1,83 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package bulk runs an operation on several entities and waits for them together
+package bulk
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+)
+
+// Operation tracks an operation started on several entities
+type Operation struct {
+	authApi  *ybmAuthClient.AuthApiClient
+	started  []ybmAuthClient.TaskWait
+	failures []ybmAuthClient.TaskWaitResult
+}
+
+func NewOperation(authApi *ybmAuthClient.AuthApiClient) *Operation {
+	return &Operation{authApi: authApi}
+}
+
+// Started records an entity the operation was started on
+func (o *Operation) Started(wait ybmAuthClient.TaskWait) {
+	o.started = append(o.started, wait)
+}
+
+// Failed records an entity the operation could not be started on
+func (o *Operation) Failed(wait ybmAuthClient.TaskWait, err error) {
+	logrus.Errorf("%s: %s", wait.Name, err)
+	o.failures = append(o.failures, ybmAuthClient.TaskWaitResult{TaskWait: wait, State: "ERROR", Error: err.Error()})
+}
+
+// Wait waits for the started tasks together, with one progress line per entity,
+// then prints the summary table, entities which failed to start included
+func (o *Operation) Wait() []ybmAuthClient.TaskWaitResult {
+	results := []ybmAuthClient.TaskWaitResult{}
+	if len(o.started) > 0 {
+		results = o.authApi.WaitForTasks(o.started, []string{"FAILED", "SUCCEEDED"})
+	}
+	results = append(results, o.failures...)
+
+	summaryCtx := formatter.Context{
+		Output: os.Stdout,
+		Format: formatter.NewTaskWaitFormat(viper.GetString("output")),
+	}
+	formatter.TaskWaitWrite(summaryCtx, results)
+	return results
+}
+
+// ExitOnFailure exits with an error when the operation could not be started on
+// an entity, or when one of results did not succeed
+func (o *Operation) ExitOnFailure(results []ybmAuthClient.TaskWaitResult) {
+	failed := len(o.failures)
+	total := len(o.started) + len(o.failures)
+	if results != nil {
+		failed = 0
+		for _, result := range results {
+			if !result.Succeeded() {
+				failed++
+			}
+		}
+	}
+	if failed > 0 {
+		logrus.Fatalf("%d of %d operations did not succeed", failed, total)
+	}
+}
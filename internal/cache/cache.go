@@ -0,0 +1,145 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package cache keeps slow-changing catalog data (regions, node
+// configurations, tracks, permissions) on disk so repeated invocations of
+// the CLI do not issue the same API calls over and over.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+type entry struct {
+	Key      string          `json:"key"`
+	StoredAt time.Time       `json:"stored_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Cache stores one JSON file per key in a directory
+type Cache struct {
+	dir      string
+	disabled bool
+	now      func() time.Time
+}
+
+// New returns a cache rooted at dir. A disabled cache never hits and never writes.
+func New(dir string, disabled bool) *Cache {
+	return &Cache{dir: dir, disabled: disabled, now: time.Now}
+}
+
+// Dir returns the directory used by the CLI cache, under the user cache dir
+func Dir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "ybm-cli"), nil
+}
+
+// FromConfig returns the CLI cache, disabled by --no-cache or when no cache dir is available
+func FromConfig() *Cache {
+	dir, err := Dir()
+	if err != nil {
+		logrus.Debugf("Cache disabled, no user cache directory: %v", err)
+		return New("", true)
+	}
+	return New(dir, viper.GetBool("no-cache"))
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get decodes the value stored for key into value if it is younger than ttl
+func (c *Cache) Get(key string, ttl time.Duration, value interface{}) bool {
+	if c.disabled {
+		return false
+	}
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil || e.Key != key {
+		return false
+	}
+	if c.now().Sub(e.StoredAt) > ttl {
+		logrus.Debugf("Cache entry expired for %s", key)
+		return false
+	}
+	if err := json.Unmarshal(e.Data, value); err != nil {
+		logrus.Debugf("Unable to decode cache entry for %s: %v", key, err)
+		return false
+	}
+	logrus.Debugf("Cache hit for %s", key)
+	return true
+}
+
+// Set stores value for key. The file is written then renamed so concurrent
+// invocations never read a partial entry.
+func (c *Cache) Set(key string, value interface{}) error {
+	if c.disabled {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(entry{Key: key, StoredAt: c.now(), Data: data})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.dir, ".entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(key))
+}
+
+// Clear removes every cached entry
+func (c *Cache) Clear() error {
+	if c.dir == "" {
+		return nil
+	}
+	err := os.RemoveAll(c.dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not clear cache %s: %w", c.dir, err)
+	}
+	return nil
+}
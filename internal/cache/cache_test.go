@@ -0,0 +1,77 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		dir string
+		now time.Time
+		c   *Cache
+	)
+
+	BeforeEach(func() {
+		dir = filepath.Join(GinkgoT().TempDir(), "ybm-cli")
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		c = New(dir, false)
+		c.now = func() time.Time { return now }
+	})
+
+	It("should return a stored value until the ttl expires", func() {
+		Expect(c.Set("host/account/tracks", []string{"Stable", "Preview"})).To(Succeed())
+
+		var tracks []string
+		now = now.Add(30 * time.Minute)
+		Expect(c.Get("host/account/tracks", time.Hour, &tracks)).To(BeTrue())
+		Expect(tracks).To(Equal([]string{"Stable", "Preview"}))
+
+		now = now.Add(time.Hour)
+		Expect(c.Get("host/account/tracks", time.Hour, &tracks)).To(BeFalse())
+	})
+
+	It("should miss for another key", func() {
+		Expect(c.Set("host/account/tracks", []string{"Stable"})).To(Succeed())
+		var tracks []string
+		Expect(c.Get("host/other-account/tracks", time.Hour, &tracks)).To(BeFalse())
+	})
+
+	It("should never read nor write when disabled", func() {
+		disabled := New(dir, true)
+		Expect(disabled.Set("key", "value")).To(Succeed())
+		_, err := os.Stat(dir)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		Expect(c.Set("key", "value")).To(Succeed())
+		var value string
+		Expect(disabled.Get("key", time.Hour, &value)).To(BeFalse())
+	})
+
+	It("should remove every entry on clear", func() {
+		Expect(c.Set("key", "value")).To(Succeed())
+		Expect(c.Clear()).To(Succeed())
+		var value string
+		Expect(c.Get("key", time.Hour, &value)).To(BeFalse())
+		Expect(c.Clear()).To(Succeed())
+	})
+})
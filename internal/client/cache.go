@@ -0,0 +1,54 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yugabyte/ybm-cli/internal/cache"
+)
+
+// Time to live of each cached resource type
+const (
+	CloudRegionsCacheTTL        = 24 * time.Hour
+	NodeConfigurationsCacheTTL  = time.Hour
+	TracksCacheTTL              = time.Hour
+	ResourcePermissionsCacheTTL = 24 * time.Hour
+)
+
+// cached returns the response of fetch from the on-disk cache when a fresh entry
+// exists, otherwise it calls the API and stores the response. Entries are scoped
+// by host and account so switching profile never returns another account's data.
+func cached[T any](a *AuthApiClient, ttl time.Duration, key []string, fetch func() (T, *http.Response, error)) (T, *http.Response, error) {
+	c := cache.FromConfig()
+	fullKey := strings.Join(append([]string{a.ApiClient.GetConfig().Host, a.AccountID}, key...), "/")
+
+	var value T
+	if c.Get(fullKey, ttl, &value) {
+		return value, nil, nil
+	}
+	value, r, err := fetch()
+	if err != nil {
+		return value, r, err
+	}
+	if err := c.Set(fullKey, value); err != nil {
+		logrus.Debugf("Unable to write cache entry for %s: %v", fullKey, err)
+	}
+	return value, r, nil
+}
@@ -18,7 +18,9 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -27,6 +29,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -34,6 +37,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/yugabyte/ybm-cli/cmd/util"
+	"github.com/yugabyte/ybm-cli/internal/notify"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 	"golang.org/x/exp/slices"
 )
@@ -81,6 +86,25 @@ func NewAuthApiClientCustomUrlKey(url *url.URL, apiKey string) (*AuthApiClient,
 	configuration.Host = url.Host
 	//configuration.Debug = true
 	configuration.Scheme = url.Scheme
+	httpClient, err := NewHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	recorder, err := GetTraceRecorder()
+	if err != nil {
+		return nil, err
+	}
+	if recorder != nil {
+		httpClient.Transport = recorder.Transport(httpClient.Transport)
+	}
+	if DryRun() {
+		httpClient.Transport = &dryRunTransport{next: httpClient.Transport}
+		dryRunHandler.Do(func() {
+			// logrus.Fatal skips the post run of the command
+			logrus.RegisterExitHandler(EndDryRun)
+		})
+	}
+	configuration.HTTPClient = httpClient
 	apiClient := ybmclient.NewAPIClient(configuration)
 
 	apiClient.GetConfig().AddDefaultHeader("Authorization", "Bearer "+apiKey)
@@ -106,377 +130,168 @@ func (a *AuthApiClient) Ping() ybmclient.ApiGetPingRequest {
 	return a.ApiClient.HealthCheckApi.GetPing(a.ctx)
 }
 
-func (a *AuthApiClient) GetAccountID(accountID string) (string, error) {
-	//If an account ID is provided then we use this one
-	if len(accountID) > 0 {
-		return accountID, nil
-	}
-	accountResp, _, err := a.GetAccount().Execute()
-	if err != nil {
-		return "", err
-	}
+// SDK returns the public client sharing the API client, account and project of a
+func (a *AuthApiClient) SDK() *ybm.Client {
+	return ybm.NewWithAPIClient(a.ApiClient, a.AccountID, a.ProjectID)
+}
 
-	return accountResp.Data.Info.Id, nil
+func (a *AuthApiClient) GetAccountID(accountID string) (string, error) {
+	return a.SDK().ResolveAccountID(a.ctx, accountID)
 }
 
 func (a *AuthApiClient) GetProjectID(projectID string) (string, error) {
-	// If a projectID is specified then we use this one.
-	if len(projectID) > 0 {
-		return projectID, nil
-	}
-
-	accountResp, _, err := a.GetAccount().Execute()
-	if err != nil {
-		return "", err
-	}
-	projectData := accountResp.Data.Info.GetProjects()
-	if len(projectData) == 0 {
-		return "", fmt.Errorf("the account is not associated with any projects")
-	}
-	if len(projectData) > 1 {
-		return "", fmt.Errorf("the account is associated with multiple projects, please provide a project id")
-	}
-	return projectData[0].Info.Id, nil
+	return a.SDK().ResolveProjectID(a.ctx, projectID)
 }
 
-func optionalClusterNodeInfoEquals(left ybmclient.OptionalClusterNodeInfo, right ybmclient.OptionalClusterNodeInfo) bool {
-	return left.MemoryMb == right.MemoryMb && left.NumCores == right.NumCores && left.DiskSizeGb == right.DiskSizeGb && left.HasDiskIops() == right.HasDiskIops() && left.GetDiskIops() == right.GetDiskIops()
+// debugResponse logs the full HTTP response of a failed call
+func debugResponse(resp *http.Response, err error) {
+	if err != nil && resp != nil {
+		b, _ := httputil.DumpResponse(resp, true)
+		logrus.Debug(string(b))
+	}
 }
 
-func (a *AuthApiClient) buildClusterSpec(cmd *cobra.Command, regionInfoList []map[string]string, regionNodeConfigsMap map[string][]ybmclient.NodeConfigurationResponseItem) (*ybmclient.ClusterSpec, error) {
-
-	var trackId string
-	var trackName string
-	var err error
-
-	var clusterRegionInfo []ybmclient.ClusterRegionInfo
-	totalNodes := 0
-	regionNodeInfoMap := map[string]*ybmclient.OptionalClusterNodeInfo{}
+// clusterSpecOptions reads the cluster flags of cmd. Only the flags given on the
+// command line are set, the others are left to the API defaults.
+func clusterSpecOptions(cmd *cobra.Command, regionInfoList []map[string]string) (ybm.ClusterSpecOptions, error) {
+	opts := ybm.ClusterSpecOptions{}
 	for _, regionInfo := range regionInfoList {
-		numNodes, err := strconv.ParseInt(regionInfo["num-nodes"], 10, 32)
+		regionSpec, err := ybm.ParseRegionSpec(regionInfo)
 		if err != nil {
-			return nil, err
-		}
-		regionNodes := int32(numNodes)
-		region := regionInfo["region"]
-		totalNodes += int(regionNodes)
-		cloudInfo := *ybmclient.NewCloudInfoWithDefaults()
-		cloudInfo.SetRegion(region)
-		if cmd.Flags().Changed("cloud-provider") {
-			cloudProvider, _ := cmd.Flags().GetString("cloud-provider")
-			cloudInfo.SetCode(ybmclient.CloudEnum(cloudProvider))
-		}
-		info := *ybmclient.NewClusterRegionInfo(
-			*ybmclient.NewPlacementInfo(cloudInfo, regionNodes),
-		)
-		if vpcName, ok := regionInfo["vpc"]; ok {
-			vpcID, err := a.GetVpcIdByName(vpcName)
-			if err != nil {
-				logrus.Error(err)
-				return nil, err
-			}
-			info.PlacementInfo.SetVpcId(vpcID)
-		}
-		if cmd.Flags().Changed("cluster-type") {
-			clusterType, _ := cmd.Flags().GetString("cluster-type")
-			if clusterType == "GEO_PARTITIONED" {
-				info.PlacementInfo.SetMultiZone(true)
-			}
-		}
-		info.SetIsDefault(false)
-
-		if replicationTarget, ok := regionInfo["backup-replication-gcp-target"]; ok {
-			info.SetBackupReplicationGcpTarget(replicationTarget)
-		}
-		clusterRegionInfo = append(clusterRegionInfo, info)
-
-		regionNodeInfo := ybmclient.NewOptionalClusterNodeInfo(0, 0, 0)
-		if numCores, ok := regionInfo["num-cores"]; ok {
-			i, err := strconv.Atoi(numCores)
-			if err != nil {
-				logrus.Fatalf("Unable to parse num-cores integer in %s", region)
-			}
-			regionNodeInfo.SetNumCores(int32(i))
-		}
-		if diskSizeGb, ok := regionInfo["disk-size-gb"]; ok {
-			i, err := strconv.Atoi(diskSizeGb)
-			if err != nil {
-				logrus.Fatalf("Unable to parse disk-size-gb integer in %s", region)
-			}
-			regionNodeInfo.SetDiskSizeGb(int32(i))
-		}
-		if diskIops, ok := regionInfo["disk-iops"]; ok {
-			i, err := strconv.Atoi(diskIops)
-			if err != nil {
-				logrus.Fatalf("Unable to parse disk-iops integer in %s", region)
-			}
-			regionNodeInfo.SetDiskIops(int32(i))
+			return opts, err
 		}
-		regionNodeInfoMap[region] = regionNodeInfo
+		opts.Regions = append(opts.Regions, regionSpec)
 	}
 
-	regionCount := len(clusterRegionInfo)
-	if regionCount > 0 {
-		if regionCount == 1 {
-			clusterRegionInfo[0].SetIsDefault(true)
-		}
-	} else {
-		return nil, fmt.Errorf("region info must be provided")
-	}
-
-	// For the default tier which is FREE, isProduction has to be false
-	isProduction := false
-
-	clusterName, _ := cmd.Flags().GetString("cluster-name")
+	opts.Name, _ = cmd.Flags().GetString("cluster-name")
 	if cmd.Flags().Changed("new-name") {
-		clusterName, _ = cmd.Flags().GetString("new-name")
-	}
-	cloud := ""
-	if cmd.Flags().Changed("cloud-provider") {
-		cloudProvider, _ := cmd.Flags().GetString("cloud-provider")
-		cloud = cloudProvider
-	}
-
-	clusterInfo := *ybmclient.NewClusterInfoWithDefaults()
-	if cmd.Flags().Changed("cluster-tier") {
-		clusterTierCli, _ := cmd.Flags().GetString("cluster-tier")
-		clusterTier, err := util.GetClusterTier(clusterTierCli)
-		if err != nil {
-			return nil, err
-		}
-		if clusterTier == "PAID" {
-			isProduction = true
-		}
-		clusterInfo.SetClusterTier(ybmclient.ClusterTier(clusterTier))
-	}
-
-	if totalNodes != 0 {
-		clusterInfo.SetNumNodes(int32(totalNodes))
-	}
-	if cmd.Flags().Changed("fault-tolerance") {
-		faultTolerance, _ := cmd.Flags().GetString("fault-tolerance")
-		clusterInfo.SetFaultTolerance(ybmclient.ClusterFaultTolerance(faultTolerance))
-	}
-	if cmd.Flags().Changed("preferred-region") {
-		preferredRegion, _ := cmd.Flags().GetString("preferred-region")
-		if clusterInfo.GetFaultTolerance() != "REGION" {
-			return nil, fmt.Errorf("preferred region is allowed only for regional level fault tolerance")
-		}
-
-		if len(clusterRegionInfo) <= 1 {
-			return nil, fmt.Errorf("preferred region is allowed only if there is more than one region")
-		}
-
-		err = util.SetPreferredRegion(clusterRegionInfo, preferredRegion)
-		if err != nil {
-			return nil, err
+		opts.Name, _ = cmd.Flags().GetString("new-name")
+	}
+	for flag, value := range map[string]*string{
+		"cloud-provider":   &opts.CloudProvider,
+		"cluster-tier":     &opts.Tier,
+		"fault-tolerance":  &opts.FaultTolerance,
+		"cluster-type":     &opts.ClusterType,
+		"preferred-region": &opts.PreferredRegion,
+		"default-region":   &opts.DefaultRegion,
+		"database-version": &opts.DatabaseVersion,
+	} {
+		if cmd.Flags().Changed(flag) {
+			*value, _ = cmd.Flags().GetString(flag)
 		}
-
 	}
-
 	if cmd.Flags().Changed("num-faults-to-tolerate") {
 		numFaultsToTolerate, _ := cmd.Flags().GetInt32("num-faults-to-tolerate")
-		if valid, err := util.ValidateNumFaultsToTolerate(numFaultsToTolerate, clusterInfo.GetFaultTolerance()); !valid {
-			return nil, err
-		}
-		clusterInfo.SetNumFaultsToTolerate(numFaultsToTolerate)
-	}
-	if util.IsFeatureFlagEnabled(util.ENTERPRISE_SECURITY) {
-		if cmd.Flags().Changed("enterprise-security") {
-			enterpriseSecurity, _ := cmd.Flags().GetBool("enterprise-security")
-			clusterInfo.SetEnterpriseSecurity(enterpriseSecurity)
-		}
-	}
-	clusterInfo.SetIsProduction(isProduction)
-
-	if cmd.Flags().Changed("cluster-type") {
-		clusterType, _ := cmd.Flags().GetString("cluster-type")
-		clusterInfo.SetClusterType(ybmclient.ClusterType(clusterType))
-	}
-
-	tier := string(clusterInfo.GetClusterTier())
-
-	geoPartitioned := clusterInfo.GetClusterType() == "GEO_PARTITIONED"
-	clusterNodeInfoWithDefaults := *ybmclient.NewClusterNodeInfoWithDefaults()
-	// Create slice of desired regions.
-	regions := make([]string, 0, len(regionNodeInfoMap))
-	for k := range regionNodeInfoMap {
-		regions = append(regions, k)
-	}
-
-	if regionNodeConfigsMap == nil {
-		// Grab available node configurations by region.
-		regionNodeConfigsMap = a.GetSupportedNodeConfigurationsV2(cloud, tier, regions, geoPartitioned)
+		opts.NumFaultsToTolerate = &numFaultsToTolerate
 	}
-
-	// Create slice of region keys of node configurations response.
-	nodeConfigurationsRegions := make([]string, 0, len(regionNodeConfigsMap))
-	for k := range regionNodeConfigsMap {
-		nodeConfigurationsRegions = append(nodeConfigurationsRegions, k)
+	if util.IsFeatureFlagEnabled(util.ENTERPRISE_SECURITY) && cmd.Flags().Changed("enterprise-security") {
+		enterpriseSecurity, _ := cmd.Flags().GetBool("enterprise-security")
+		opts.EnterpriseSecurity = &enterpriseSecurity
 	}
-	// For each desired region, grab appropriate node configuration and set node info.
-	for _, r := range regions {
-		var nodeConfigs []ybmclient.NodeConfigurationResponseItem
-		if slices.Contains(nodeConfigurationsRegions, r) {
-			nodeConfigs = regionNodeConfigsMap[r]
-		} else {
-			// Requested region not found in node configurations map.
-			// In this case, the map key is a string of all (comma-separated) regions,
-			// and the value is a list of node configurations that are available in all regions.
-			// So, we just use look through the first map value to find a node configuration to use.
-			nodeConfigs = regionNodeConfigsMap[nodeConfigurationsRegions[0]]
-		}
-		requestedNodeInfo := regionNodeInfoMap[r]
-		requestedNumCores := requestedNodeInfo.GetNumCores()
-		userProvidedNumCores := requestedNumCores != 0
+	return opts, nil
+}
 
-		var nodeConfig *ybmclient.NodeConfigurationResponseItem = nil
-		if !userProvidedNumCores {
-			requestedNumCores = clusterNodeInfoWithDefaults.GetNumCores()
-		}
-		for i, nc := range nodeConfigs {
-			if nc.GetNumCores() == requestedNumCores {
-				nodeConfig = &nodeConfigs[i]
-				break
-			}
-		}
-		if nodeConfig == nil {
-			logrus.Fatalf("No instance type found with %d cores in region %s\n", requestedNumCores, r)
-		}
-		regionNodeInfoMap[r].SetNumCores(nodeConfig.GetNumCores())
-		regionNodeInfoMap[r].SetMemoryMb(nodeConfig.GetMemoryMb())
-		if requestedNodeInfo.GetDiskSizeGb() == 0 {
-			// User did not specify a disk size. Default to included disk size.
-			regionNodeInfoMap[r].SetDiskSizeGb(nodeConfig.GetIncludedDiskSizeGb())
-		}
-	}
-	// Set per-region node info and cluster node info.
-	var currRegionNodeInfo *ybmclient.OptionalClusterNodeInfo = nil
-	for i, regionInfo := range clusterRegionInfo {
-		r := regionInfo.GetPlacementInfo().CloudInfo.Region
-		clusterRegionInfo[i].SetNodeInfo(*regionNodeInfoMap[r])
-		logrus.Debugf("region=%s, node-info=%v\n", r, clusterRegionInfo[i].GetNodeInfo())
-		if currRegionNodeInfo != nil && !geoPartitioned && !optionalClusterNodeInfoEquals(*currRegionNodeInfo, clusterRegionInfo[i].GetNodeInfo()) {
-			// Asymmetric node configurations are only allowed for geo-partitioned clusters.
-			logrus.Fatalln("Synchronous cluster regions must have identical node configurations")
-		}
-		currRegionNodeInfo = (&clusterRegionInfo[i]).NodeInfo.Get()
+func (a *AuthApiClient) buildClusterSpec(cmd *cobra.Command, regionInfoList []map[string]string, clusterID string) (*ybmclient.ClusterSpec, error) {
+	opts, err := clusterSpecOptions(cmd, regionInfoList)
+	if err != nil {
+		return nil, err
 	}
+	return a.BuildClusterSpec(opts, clusterID)
+}
 
-	if cmd.Flags().Changed("default-region") {
-		defaultRegion, _ := cmd.Flags().GetString("default-region")
-		if clusterInfo.GetClusterType() != "GEO_PARTITIONED" {
-			return nil, fmt.Errorf("default region is allowed only for geo partitioned clusters")
-		}
-
-		if len(clusterRegionInfo) <= 1 {
-			return nil, fmt.Errorf("default region is allowed only if there is more than one region")
-		}
-
-		err = util.SetDefaultRegion(clusterRegionInfo, defaultRegion)
-		if err != nil {
+// BuildClusterSpec returns the spec of a new cluster, or of the edit of
+// clusterID when not empty. Tracks and node configurations are resolved
+// through the on-disk cache.
+func (a *AuthApiClient) BuildClusterSpec(opts ybm.ClusterSpecOptions, clusterID string) (*ybmclient.ClusterSpec, error) {
+	var err error
+	if opts.DatabaseVersion != "" && opts.TrackID == "" {
+		if opts.TrackID, err = a.GetTrackIdByName(opts.DatabaseVersion); err != nil {
 			return nil, err
 		}
-
+		logrus.Debugf("Resolved database version '%s' to track ID: %s", opts.DatabaseVersion, opts.TrackID)
 	}
-
-	// Compute track ID for database version
-	softwareInfo := *ybmclient.NewSoftwareInfoWithDefaults()
-	if cmd.Flags().Changed("database-version") {
-		trackName, _ = cmd.Flags().GetString("database-version")
-		trackId, err = a.GetTrackIdByName(trackName)
-		if err != nil {
+	if clusterID != "" && opts.NodeConfigurations == nil {
+		regions := make([]string, 0, len(opts.Regions))
+		for _, region := range opts.Regions {
+			regions = append(regions, region.Region)
+		}
+		if opts.NodeConfigurations, err = a.SDK().NodeConfigurationsForEdit(a.ctx, clusterID, regions); err != nil {
 			return nil, err
 		}
-		logrus.Debugf("Resolved database version '%s' to track ID: %s", trackName, trackId)
-		softwareInfo.SetTrackId(trackId)
 	}
-
-	clusterSpec := ybmclient.NewClusterSpec(
-		clusterName,
-		clusterInfo,
-		softwareInfo)
-	clusterSpec.SetClusterRegionInfo(clusterRegionInfo)
-
-	return clusterSpec, nil
+	opts.NodeConfigurationsFunc = a.supportedNodeConfigurationsCached
+	return a.SDK().BuildClusterSpec(a.ctx, opts)
 }
 
 func (a *AuthApiClient) CreateClusterSpec(cmd *cobra.Command, regionInfoList []map[string]string) (*ybmclient.ClusterSpec, error) {
-	return a.buildClusterSpec(cmd, regionInfoList, nil)
+	return a.buildClusterSpec(cmd, regionInfoList, "")
 }
 
 func (a *AuthApiClient) EditClusterSpec(cmd *cobra.Command, regionInfoList []map[string]string, clusterID string) (*ybmclient.ClusterSpec, error) {
-	regions := make([]string, 0, len(regionInfoList))
-	for _, regionInfo := range regionInfoList {
-		region := regionInfo["region"]
-		regions = append(regions, region)
-	}
-	regionNodeConfigsMap := a.GetSupportedNodeConfigurationsForEdit(clusterID, regions)
-	return a.buildClusterSpec(cmd, regionInfoList, regionNodeConfigsMap)
+	return a.buildClusterSpec(cmd, regionInfoList, clusterID)
 }
 
+// accountInfo caches the account and project resolved by GetInfo, so that a
+// command resolving resource IDs before running does not look them up twice.
+var (
+	accountInfoMu sync.Mutex
+	accountInfo   = map[string][2]string{}
+)
+
 func (a *AuthApiClient) GetInfo(providedAccountID string, providedProjectID string) {
+	config := a.ApiClient.GetConfig()
+	key := strings.Join([]string{config.Host, config.DefaultHeader["Authorization"], providedAccountID, providedProjectID}, "|")
+	accountInfoMu.Lock()
+	defer accountInfoMu.Unlock()
+	if info, ok := accountInfo[key]; ok {
+		a.AccountID, a.ProjectID = info[0], info[1]
+		return
+	}
 	var err error
 	a.AccountID, err = a.GetAccountID(providedAccountID)
 	if err != nil {
-		logrus.Errorf(GetApiErrorDetails(err))
-		os.Exit(1)
+		logrus.Fatalf(GetApiErrorDetails(err))
 	}
 	a.ProjectID, err = a.GetProjectID(providedProjectID)
 	if err != nil {
-		logrus.Errorf(GetApiErrorDetails(err))
-		os.Exit(1)
+		logrus.Fatalf(GetApiErrorDetails(err))
 	}
+	accountInfo[key] = [2]string{a.AccountID, a.ProjectID}
 }
 
+// GetClusterByName accepts a cluster name or ID
 func (a *AuthApiClient) GetClusterByName(clusterName string) (ybmclient.ClusterData, error) {
-	clusterResp, resp, err := a.ListClusters().Name(clusterName).Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(string(b))
-		return ybmclient.ClusterData{}, err
-	}
-	clusterData := clusterResp.GetData()
-
-	if len(clusterData) != 0 {
-		return clusterData[0], nil
-	}
-
-	return ybmclient.ClusterData{}, fmt.Errorf("could not get cluster data for cluster name: %s", clusterName)
+	clusterData, resp, err := a.SDK().ClusterByName(a.ctx, lookupKey(ybm.Clusters, clusterName))
+	debugResponse(resp, err)
+	return clusterData, err
 }
 
+// GetDrByName accepts a DR config name or ID
 func (a *AuthApiClient) GetDrByName(drName string) (ybmclient.XClusterDrData, error) {
-	drResp, resp, err := a.ListXClusterDr().Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(string(b))
-		return ybmclient.XClusterDrData{}, err
-	}
-	drData := drResp.GetData()
-
-	for _, drDatum := range drData {
-		if drDatum.Spec.GetName() == drName {
-			return drDatum, nil
-		}
-	}
-
-	return ybmclient.XClusterDrData{}, fmt.Errorf("could not get data for the DR config %s", drName)
+	drData, resp, err := a.SDK().DrByName(a.ctx, lookupKey(ybm.DrConfigs, drName))
+	debugResponse(resp, err)
+	return drData, err
 }
 
 func (a *AuthApiClient) ExtractProviderFromClusterName(clusterId string) ([]string, error) {
 	clusterResp, _, err := a.GetCluster(clusterId).Execute()
-	clusterData := clusterResp.GetData()
-	var providers []string
 	if err != nil {
 		return nil, err
 	}
+	return ExtractProviders(clusterResp.GetData()), nil
+}
 
+// ExtractProviders returns the distinct cloud providers used by the regions of a cluster
+func ExtractProviders(clusterData ybmclient.ClusterData) []string {
+	var providers []string
 	if ok := clusterData.Spec.HasClusterRegionInfo(); ok {
 		if len(clusterData.GetSpec().ClusterRegionInfo) > 0 {
-			sort.Slice(clusterData.GetSpec().ClusterRegionInfo, func(i, j int) bool {
-				return string(clusterData.GetSpec().ClusterRegionInfo[i].PlacementInfo.CloudInfo.Code) < string(clusterData.GetSpec().ClusterRegionInfo[j].PlacementInfo.CloudInfo.Code)
+			// Sort a copy, the regions of the cluster may still be displayed in their own order
+			regionInfo := slices.Clone(clusterData.GetSpec().ClusterRegionInfo)
+			sort.Slice(regionInfo, func(i, j int) bool {
+				return string(regionInfo[i].PlacementInfo.CloudInfo.Code) < string(regionInfo[j].PlacementInfo.CloudInfo.Code)
 			})
-			for _, p := range clusterData.GetSpec().ClusterRegionInfo {
+			for _, p := range regionInfo {
 				//Check uniqueness of Cloud (in case multi cloud with strange distribution, AWS, GCP,AWS)
 				if !slices.Contains(providers, string(p.PlacementInfo.CloudInfo.Code)) {
 					providers = append(providers, string(p.PlacementInfo.CloudInfo.Code))
@@ -484,8 +299,9 @@ func (a *AuthApiClient) ExtractProviderFromClusterName(clusterId string) ([]stri
 			}
 		}
 	}
-	return providers, nil
+	return providers
 }
+
 func (a *AuthApiClient) GetEndpointsForClusterByName(clusterName string) ([]ybmclient.Endpoint, string, error) {
 	clusterData, err := a.GetClusterByName(clusterName)
 	if err != nil {
@@ -526,7 +342,7 @@ func (a *AuthApiClient) GetClusterIdByName(clusterName string) (string, error) {
 		return clusterData.Info.GetId(), nil
 	}
 
-	return "", fmt.Errorf("could not get cluster data for cluster name: %s", clusterName)
+	return "", err
 }
 
 func (a *AuthApiClient) GetDrDetailsByName(drName string) (ybmclient.XClusterDrInfo, error) {
@@ -535,7 +351,7 @@ func (a *AuthApiClient) GetDrDetailsByName(drName string) (ybmclient.XClusterDrI
 		return drData.GetInfo(), nil
 	}
 
-	return ybmclient.XClusterDrInfo{}, fmt.Errorf("could not get data for the DR config %s", drName)
+	return ybmclient.XClusterDrInfo{}, err
 }
 
 func (a *AuthApiClient) CreateCluster() ybmclient.ApiCreateClusterRequest {
@@ -681,20 +497,11 @@ func (a *AuthApiClient) DeleteVpc(vpcId string) ybmclient.ApiDeleteVpcRequest {
 	return a.ApiClient.NetworkApi.DeleteVpc(a.ctx, a.AccountID, a.ProjectID, vpcId)
 }
 
+// GetVpcIdByName accepts a VPC name or ID
 func (a *AuthApiClient) GetVpcIdByName(vpcName string) (string, error) {
-	vpcResp, resp, err := a.ListSingleTenantVpcs().Name(vpcName).Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(string(b))
-		return "", err
-	}
-	vpcData := vpcResp.GetData()
-
-	if len(vpcData) != 0 {
-		return vpcData[0].Info.GetId(), nil
-	}
-
-	return "", fmt.Errorf("could not get vpc data for vpc name: %s", vpcName)
+	vpcId, resp, err := a.SDK().VpcID(a.ctx, lookupKey(ybm.Vpcs, vpcName))
+	debugResponse(resp, err)
+	return vpcId, err
 }
 
 func (a *AuthApiClient) GetSingleTenantVpc(vpcId string) ybmclient.ApiGetSingleTenantVpcRequest {
@@ -705,7 +512,7 @@ func (a *AuthApiClient) GetVpcNameById(vpcId string) (string, error) {
 	vpcNameResp, resp, err := a.GetSingleTenantVpc(vpcId).Execute()
 	if err != nil {
 		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
+		logrus.Debug(string(b))
 		return "", err
 	}
 	return vpcNameResp.GetData().Spec.Name, nil
@@ -742,20 +549,9 @@ func (a *AuthApiClient) GetBackup(backupID string) ybmclient.ApiGetBackupRequest
 }
 
 func (a *AuthApiClient) GetNetworkAllowListIdByName(networkAllowListName string) (string, error) {
-	nalResp, resp, err := a.ListNetworkAllowLists().Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(string(b))
-		return "", err
-	}
-	nalData, err := util.FindNetworkAllowList(nalResp.Data, networkAllowListName)
-
-	if err != nil {
-		return "", err
-	}
-
-	return nalData.Info.GetId(), nil
-
+	nalId, resp, err := a.SDK().NetworkAllowListID(a.ctx, lookupKey(ybm.NetworkAllowLists, networkAllowListName))
+	debugResponse(resp, err)
+	return nalId, err
 }
 
 func (a *AuthApiClient) EditClusterNetworkAllowLists(clusterId string, allowListIds []string) ybmclient.ApiEditClusterNetworkAllowListsRequest {
@@ -866,7 +662,7 @@ func (a *AuthApiClient) GetTrackNameById(trackId string) (string, error) {
 	trackNameResp, resp, err := a.GetTrack(trackId).Execute()
 	if err != nil {
 		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
+		logrus.Debug(string(b))
 		return "", err
 	}
 
@@ -875,22 +671,14 @@ func (a *AuthApiClient) GetTrackNameById(trackId string) (string, error) {
 }
 
 func (a *AuthApiClient) GetTrackIdByName(trackName string) (string, error) {
-	tracksNameResp, resp, err := a.ListTracks().Execute()
+	tracksNameResp, resp, err := cached(a, TracksCacheTTL, []string{"tracks"}, a.ListTracks().Execute)
 	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
+		debugResponse(resp, err)
 		return "", err
 	}
-
-	for _, track := range tracksNameResp.GetData() {
-		// Temporary backwards compatibility between Stable and Production tracks.
-		if track.Spec.GetName() == trackName || (track.Spec.GetName() == "Production" && trackName == "Stable") {
-			return track.Info.GetId(), nil
-		}
-	}
-	return "", fmt.Errorf("the database version doesn't exist")
-
+	return ybm.TrackIDFrom(tracksNameResp.GetData(), trackName)
 }
+
 func (a *AuthApiClient) CreateCdcStream(clusterId string) ybmclient.ApiCreateCdcStreamRequest {
 	return a.ApiClient.CdcApi.CreateCdcStream(a.ctx, a.AccountID, a.ProjectID, clusterId)
 }
@@ -911,59 +699,35 @@ func (a *AuthApiClient) GetCdcStream(cdcStreamId string, clusterId string) ybmcl
 	return a.ApiClient.CdcApi.GetCdcStream(a.ctx, a.AccountID, a.ProjectID, clusterId, cdcStreamId)
 }
 
+// GetCdcStreamIDByStreamName accepts a CDC stream name or ID
 func (a *AuthApiClient) GetCdcStreamIDByStreamName(cdcStreamName string) (string, error) {
-	streamResp, resp, err := a.ListCdcStreamsForAccount().Name(cdcStreamName).Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
-		return "", err
-	}
-	streamData := streamResp.GetData()
-
-	if len(streamData) != 0 {
-		return streamData[0].Info.GetId(), nil
-	}
-
-	return "", fmt.Errorf("couldn't find any cdcStream with the given name")
+	streamId, resp, err := a.SDK().CdcStreamID(a.ctx, lookupKey(ybm.CdcStreams, cdcStreamName))
+	debugResponse(resp, err)
+	return streamId, err
 }
 
 func (a *AuthApiClient) GetSupportedNodeConfigurations(cloud string, tier string, region string) ybmclient.ApiGetSupportedNodeConfigurationsByAccountRequest {
 	return a.ApiClient.ClusterApi.GetSupportedNodeConfigurationsByAccount(a.ctx, a.AccountID).Cloud(cloud).Tier(tier).Regions([]string{region})
 }
 
-func (a *AuthApiClient) GetSupportedNodeConfigurationsV2(cloud string, tier string, regions []string, geoPartitioned bool) map[string][]ybmclient.NodeConfigurationResponseItem {
-	// For single-region clusters, set isMultiRegion = false.
-	// For azure clusters, set isMultiRegion = false.
-	// For geo clusters if FF is enabled, set isMultiRegion = false
-	// For all other clusters, set isMultiRegion = true
-	isMultiRegion := true
-	if len(regions) == 1 || cloud == "AZURE" || (geoPartitioned) {
-		isMultiRegion = false
-	}
-	instanceResp, resp, err := a.ApiClient.ClusterApi.GetSupportedNodeConfigurationsByAccount(a.ctx, a.AccountID).Cloud(cloud).Tier(tier).Regions(regions).IsMultiRegion(isMultiRegion).Execute()
+// supportedNodeConfigurationsCached lists the node configurations of regions, served from the cache when fresh
+func (a *AuthApiClient) supportedNodeConfigurationsCached(ctx context.Context, cloud string, tier string, regions []string, geoPartitioned bool) (map[string][]ybmclient.NodeConfigurationResponseItem, error) {
+	request := a.SDK().NodeConfigurationsRequest(ctx, cloud, tier, regions, geoPartitioned)
+	isMultiRegion := !(len(regions) == 1 || cloud == "AZURE" || geoPartitioned)
+	cacheKey := []string{"node-configurations", cloud, tier, strings.Join(regions, ","), strconv.FormatBool(isMultiRegion)}
+	instanceResp, resp, err := cached(a, NodeConfigurationsCacheTTL, cacheKey, request.Execute)
 	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
-		logrus.Fatalln(err)
-	}
-	return instanceResp.GetData()
-}
-
-func (a *AuthApiClient) GetSupportedNodeConfigurationsForEdit(clusterId string, regions []string) map[string][]ybmclient.NodeConfigurationResponseItem {
-	instanceResp, resp, err := a.ApiClient.ClusterApi.GetSupportedNodeConfigurationsForClusterEdit(a.ctx, a.AccountID, a.ProjectID, clusterId).Regions(regions).PerRegion(true).ShowDisabled(false).ClusterType("PRIMARY").Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
-		logrus.Fatalln(err)
+		debugResponse(resp, err)
+		return nil, err
 	}
-	return instanceResp.GetData()
+	return instanceResp.GetData(), nil
 }
 
 func (a *AuthApiClient) GetFromInstanceType(resource string, cloud string, tier string, region string, numCores int32) (int32, error) {
 	instanceResp, resp, err := a.GetSupportedNodeConfigurations(cloud, tier, region).Execute()
 	if err != nil {
 		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
+		logrus.Debug(string(b))
 		return 0, err
 	}
 	instanceData := instanceResp.GetData()
@@ -994,21 +758,11 @@ func (a *AuthApiClient) ListCdcSinks() ybmclient.ApiListCdcSinksRequest {
 	return a.ApiClient.CdcApi.ListCdcSinks(a.ctx, a.AccountID)
 }
 
+// GetCdcSinkIDBySinkName accepts a CDC sink name or ID
 func (a *AuthApiClient) GetCdcSinkIDBySinkName(cdcSinkName string) (string, error) {
-	sinkResp, resp, err := a.ListCdcSinks().Name(cdcSinkName).Execute()
-	if err != nil {
-		b, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(b)
-		return "", err
-	}
-
-	sinkData := sinkResp.GetData()
-
-	if len(sinkData) != 0 {
-		return sinkData[0].Info.GetId(), nil
-	}
-
-	return "", fmt.Errorf("couldn't find any cdcSink with the given name")
+	sinkId, resp, err := a.SDK().CdcSinkID(a.ctx, lookupKey(ybm.CdcSinks, cdcSinkName))
+	debugResponse(resp, err)
+	return sinkId, err
 }
 
 func (a *AuthApiClient) GetClusterNode(clusterId string) ybmclient.ApiGetClusterNodesRequest {
@@ -1022,48 +776,49 @@ func (a *AuthApiClient) PerformNodeOperation(clusterId string) ybmclient.ApiPerf
 func (a *AuthApiClient) GetSupportedCloudRegions() ybmclient.ApiGetSupportedCloudRegionsByAccountRequest {
 	return a.ApiClient.ClusterApi.GetSupportedCloudRegionsByAccount(a.ctx, a.AccountID)
 }
+
+// GetSupportedCloudRegionsCached lists the regions of a cloud provider, served from the cache when fresh
+func (a *AuthApiClient) GetSupportedCloudRegionsCached(cloud string) ([]ybmclient.RegionListResponseDataItem, *http.Response, error) {
+	cloudRegionsResp, resp, err := cached(a, CloudRegionsCacheTTL, []string{"cloud-regions", cloud}, a.GetSupportedCloudRegions().Cloud(cloud).Execute)
+	return cloudRegionsResp.GetData(), resp, err
+}
+
 func (a *AuthApiClient) ListTasks() ybmclient.ApiListTasksRequest {
 	return a.ApiClient.TaskApi.ListTasks(a.ctx, a.AccountID)
 }
 
+// GetTaskById returns the task with the given ID among the tasks matching filter
+func (a *AuthApiClient) GetTaskById(taskId string, filter ybm.TaskFilter) (ybmclient.TaskData, error) {
+	return a.SDK().TaskByID(a.ctx, taskId, filter)
+}
+
+func (a *AuthApiClient) GetLatestTask(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum) (*ybmclient.TaskData, error) {
+	return a.SDK().LatestTask(a.ctx, entityId, entityType, taskType)
+}
+
 func (a *AuthApiClient) ListAllRbacRoles() ybmclient.ApiListRbacRolesRequest {
-	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("ALL").Limit(100)
+	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("ALL")
 }
 
 func (a *AuthApiClient) ListSystemRbacRoles() ybmclient.ApiListRbacRolesRequest {
-	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("SYSTEM").Limit(100)
+	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("SYSTEM")
 }
 
 func (a *AuthApiClient) ListAllRbacRolesWithPermissions() ybmclient.ApiListRbacRolesRequest {
-	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("ALL").Limit(100).IncludePermissions(true)
+	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("ALL").IncludePermissions(true)
 }
 
 func (a *AuthApiClient) ListSystemRbacRolesWithPermissions() ybmclient.ApiListRbacRolesRequest {
-	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("SYSTEM").Limit(100).IncludePermissions(true)
+	return a.ApiClient.RoleApi.ListRbacRoles(a.ctx, a.AccountID).RoleTypes("SYSTEM").IncludePermissions(true)
 }
 
 func (a *AuthApiClient) CreateRoleSpec(cmd *cobra.Command, name string, permissionsMap map[string][]string) (*ybmclient.RoleSpec, error) {
-
-	var rolePermissions []ybmclient.ResourcePermissionInfo
-	for resource, ops := range permissionsMap {
-		var operationGroups []ybmclient.ResourceOperationGroup
-		for _, op := range ops {
-			operationGroups = append(operationGroups, *ybmclient.NewResourceOperationGroup(ybmclient.ResourceOperationGroupEnum(op)))
-
-		}
-		rolePermissions = append(rolePermissions, *ybmclient.NewResourcePermissionInfo(ybmclient.ResourceTypeEnum(resource), operationGroups))
-	}
-
-	roleSpec := ybmclient.NewRoleSpec(
-		name,
-		rolePermissions)
-
+	opts := ybm.RoleSpecOptions{Name: name, Permissions: permissionsMap}
 	if cmd.Flags().Changed("description") {
 		description, _ := cmd.Flags().GetString("description")
-		roleSpec.SetDescription(description)
+		opts.Description = &description
 	}
-
-	return roleSpec, nil
+	return ybm.BuildRoleSpec(opts), nil
 }
 
 func (a *AuthApiClient) CreateRole() ybmclient.ApiCreateRoleRequest {
@@ -1084,51 +839,34 @@ func (a *AuthApiClient) GetRoleIdByName(roleName string) (string, error) {
 		return roleData.Info.GetId(), nil
 	}
 
-	return "", fmt.Errorf("could not get role data for role name: %s", roleName)
+	return "", err
 }
 
+// GetRoleByName accepts a role name or ID
 func (a *AuthApiClient) GetRoleByName(roleName string) (ybmclient.RoleData, error) {
-	roleResp, resp, err := a.ListAllRbacRoles().DisplayName(roleName).Execute()
-	if err != nil {
-		if strings.TrimSpace(GetApiErrorDetails(err)) == strings.TrimSpace(util.GetCustomRoleFeatureFlagDisabledError()) {
-			systemRoleResponse, systemRoleResp, systemRoleErr := a.ListSystemRbacRoles().DisplayName(roleName).Execute()
-
-			if systemRoleErr != nil {
-				b, _ := httputil.DumpResponse(systemRoleResp, true)
-				logrus.Debug(string(b))
-				return ybmclient.RoleData{}, systemRoleErr
-			} else {
-				roleResp = systemRoleResponse
-			}
-		} else {
-			c, _ := httputil.DumpResponse(resp, true)
-			logrus.Debug(string(c))
-			return ybmclient.RoleData{}, err
-		}
-	}
-
-	roleData := roleResp.GetData()
-
-	if len(roleData) != 0 {
-		return roleData[0], nil
-	}
-
-	return ybmclient.RoleData{}, fmt.Errorf("could not get role data for role name: %s", roleName)
+	roleData, resp, err := a.SDK().RoleByName(a.ctx, lookupKey(ybm.Roles, roleName))
+	debugResponse(resp, err)
+	return roleData, err
 }
 
 func (a *AuthApiClient) ListResourcePermissions() ybmclient.ApiListResourcePermissionsRequest {
 	return a.ApiClient.AuthApi.ListResourcePermissions(a.ctx)
 }
 
+// ListResourcePermissionsCached lists the permissions available to roles, served from the cache when fresh
+func (a *AuthApiClient) ListResourcePermissionsCached() ([]ybmclient.ResourcePermissionsData, *http.Response, error) {
+	resourcePermissionsResp, resp, err := cached(a, ResourcePermissionsCacheTTL, []string{"resource-permissions"}, a.ListResourcePermissions().Execute)
+	return resourcePermissionsResp.GetData(), resp, err
+}
+
 func (a *AuthApiClient) GetSensitivePermissions() (map[string][]string, error) {
-	resourcePermissionsResp, resp, err := a.ListResourcePermissions().Execute()
+	permissions, resp, err := a.ListResourcePermissionsCached()
 	if err != nil {
 		c, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(c)
+		logrus.Debug(string(c))
 		return nil, err
 	}
 
-	permissions := resourcePermissionsResp.GetData()
 	sensitivePermissionsMap := map[string][]string{}
 	for _, permission := range permissions {
 		resourceType := string(permission.Info.GetResourceType())
@@ -1214,29 +952,13 @@ func (a *AuthApiClient) CreateApiKey() ybmclient.ApiCreateApiKeyRequest {
 }
 
 func (a *AuthApiClient) GetKeyIdByName(name string) (string, error) {
-	apiKeyData, err := a.GetApiKeyByName(name)
-	if err == nil {
-		return apiKeyData.Info.GetId(), nil
-	}
-
-	return "", fmt.Errorf("could not get API key data for name: %s", name)
+	return a.SDK().ApiKeyID(a.ctx, lookupKey(ybm.ApiKeys, name))
 }
 
 func (a *AuthApiClient) GetApiKeyByName(name string) (ybmclient.ApiKeyData, error) {
-	keyResp, resp, err := a.ListApiKeys().ApiKeyName(name).Execute()
-	if err != nil {
-		c, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(c)
-		return ybmclient.ApiKeyData{}, err
-	}
-
-	keyData := keyResp.GetData()
-
-	if len(keyData) != 0 {
-		return keyData[0], nil
-	}
-
-	return ybmclient.ApiKeyData{}, fmt.Errorf("could not get API Key data for name: %s", name)
+	keyData, resp, err := a.SDK().ApiKeyByName(a.ctx, lookupKey(ybm.ApiKeys, name))
+	debugResponse(resp, err)
+	return keyData, err
 }
 
 func (a *AuthApiClient) ListAccountUsers() ybmclient.ApiListAccountUsersRequest {
@@ -1280,15 +1002,13 @@ func (a *AuthApiClient) GetUserIdByEmail(email string) (string, error) {
 }
 
 func (a *AuthApiClient) GetUserByEmail(email string) (ybmclient.UserData, error) {
-	userResp, resp, err := a.ListAccountUsers().Email(email).Execute()
+	userData, resp, err := a.ListAccountUsersPaged(a.ListAccountUsers().Email(email), AllPages)
 	if err != nil {
 		c, _ := httputil.DumpResponse(resp, true)
-		logrus.Debug(c)
+		logrus.Debug(string(c))
 		return ybmclient.UserData{}, err
 	}
 
-	userData := userResp.GetData()
-
 	if len(userData) != 0 {
 		return userData[0], nil
 	}
@@ -1296,132 +1016,260 @@ func (a *AuthApiClient) GetUserByEmail(email string) (ybmclient.UserData, error)
 	return ybmclient.UserData{}, fmt.Errorf("could not get user data for email: %s", email)
 }
 
+// WaitForTaskCompletion waits for the latest task of taskType on the entity and
+// reports its progress as set by --progress
 func (a *AuthApiClient) WaitForTaskCompletion(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, completionStatus []string, message string) (string, error) {
-	if strings.ToLower(os.Getenv("YBM_CI")) == "true" {
-		return a.WaitForTaskCompletionCI(entityId, entityType, taskType, completionStatus, message)
-	}
-	return a.WaitForTaskCompletionFull(entityId, entityType, taskType, completionStatus, message)
-
+	subject := notify.Event{EntityType: string(entityType), EntityID: entityId, TaskType: string(taskType)}
+	return waitWithProgress(a.entityTaskWaiter(entityId, entityType, taskType, completionStatus), completionStatus, message, subject)
 }
 
 func (a *AuthApiClient) WaitForTaskCompletionCI(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, completionStatus []string, message string) (string, error) {
-	var taskList ybmclient.TaskListResponse
-	var resp *http.Response
-	var err error
-	currentStatus := "UNKNOWN"
-	previousStatus := "UNKNOWN"
-	output := fmt.Sprintf(" %s: %s", message, currentStatus)
-	timeout := time.After(viper.GetDuration("timeout"))
-	checkEveryInSec := time.Tick(10 * time.Second)
-	fmt.Println(output)
-	for {
-		select {
-		case <-timeout:
-			return "", fmt.Errorf("wait timeout, operation could still be on-going")
-		case <-a.ctx.Done():
-			return "", fmt.Errorf("receive interrupt signal, operation could still be on-going")
-		case <-checkEveryInSec:
-			apiRequest := a.ListTasks().TaskType(taskType).ProjectId(a.ProjectID).EntityId(entityId).Limit(1)
-			//Sometimes the api do not need any entity type, for example VPC, VPC_PEERING
-			if len(entityType) > 0 {
-				apiRequest.EntityType(entityType)
-			}
-			taskList, resp, err = apiRequest.Execute()
-			if err != nil {
-				logrus.Debugf("Full HTTP response: %v", resp)
-				return "", fmt.Errorf(GetApiErrorDetails(err))
-			}
+	return waitWithStatusLines(a.entityTaskWaiter(entityId, entityType, taskType, completionStatus), completionStatus, message)
+}
 
-			if v, ok := taskList.GetDataOk(); ok && v != nil {
-				c := taskList.GetData()
-				if len(c) > 0 {
-					if status, ok := c[0].GetInfoOk(); ok {
-						previousStatus = currentStatus
-						currentStatus = status.GetState()
-					}
-					output = fmt.Sprintf(" %s: %s", message, currentStatus)
-					if taskProgressInfo, _ := c[0].Info.GetTaskProgressInfoOk(); ok && taskProgressInfo != nil {
+func (a *AuthApiClient) WaitForTaskCompletionFull(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, completionStatus []string, message string) (string, error) {
+	return waitWithSpinner(a.entityTaskWaiter(entityId, entityType, taskType, completionStatus), message)
+}
+
+// WaitForTaskIdCompletion waits for the task with the given ID among the tasks
+// matching filter, with the same output as WaitForTaskCompletion
+func (a *AuthApiClient) WaitForTaskIdCompletion(taskId string, filter ybm.TaskFilter, completionStatus []string, message string) (string, error) {
+	return waitWithProgress(func(onPoll func(task *ybmclient.TaskData, state string)) (string, error) {
+		state, err := a.SDK().WaitForTaskID(a.ctx, taskId, filter, a.waitOptions(completionStatus, onPoll))
+		return state, waitError(err)
+	}, completionStatus, message, notify.Event{TaskID: taskId})
+}
+
+// WaitForClusterConditions waits until the cluster satisfies every condition,
+// reporting every status change as set by --progress
+func (a *AuthApiClient) WaitForClusterConditions(clusterId string, conditions []ybm.ClusterCondition, message string) (ybm.ClusterStatus, error) {
+	jsonProgress := viper.GetString("progress") == "json"
+	encoder := json.NewEncoder(os.Stderr)
+	last := ""
+	status, err := a.SDK().WaitForCluster(a.ctx, clusterId, conditions, GetPollOptions(), func(status ybm.ClusterStatus) {
+		if status.String() == last {
+			return
+		}
+		last = status.String()
+		if !jsonProgress {
+			fmt.Fprintf(os.Stderr, " %s: %s\n", message, status)
+			return
+		}
+		event := map[string]interface{}{"time": time.Now().UTC(), "cluster_id": clusterId, "status": status, "satisfied": status.Satisfies(conditions)}
+		if err := encoder.Encode(event); err != nil {
+			logrus.Debugf("Unable to write the cluster event: %v", err)
+		}
+	})
+	return status, waitError(err)
+}
 
-						for index, action := range taskProgressInfo.GetActions() {
-							output = output + "\n" + ". Task " + strconv.Itoa(index+1) + ": " + action.GetName() + " " + strconv.Itoa(int(action.GetPercentComplete())) + "% completed"
-						}
-					}
-				} else {
-					currentStatus = "SUCCEEDED"
-				}
-			}
-			if slices.Contains(completionStatus, currentStatus) {
-				return currentStatus, nil
-			}
-			if previousStatus != currentStatus {
-				fmt.Println(output)
-			}
+// taskWaiter waits for a task, calling onPoll after every poll
+type taskWaiter func(onPoll func(task *ybmclient.TaskData, state string)) (string, error)
+
+// waitWithProgress reports the progress as JSON events with --progress json, as
+// status lines when YBM_CI is true and with a spinner otherwise. The failure
+// details of a FAILED task are printed to stderr, and the --notify targets are
+// notified of the outcome, whatever it is.
+func waitWithProgress(wait taskWaiter, completionStatus []string, message string, subject notify.Event) (string, error) {
+	var lastTask *ybmclient.TaskData
+	tracked := func(onPoll func(task *ybmclient.TaskData, state string)) (string, error) {
+		return wait(func(task *ybmclient.TaskData, state string) {
+			lastTask = task
+			onPoll(task, state)
+		})
+	}
+
+	subject.StartedAt = time.Now()
+	var state string
+	var err error
+	switch {
+	case viper.GetString("progress") == "json":
+		// The events of a failed task carry its failure details
+		state, err = waitWithJsonEvents(tracked, os.Stderr)
+	case strings.ToLower(os.Getenv("YBM_CI")) == "true":
+		state, err = waitWithStatusLines(tracked, completionStatus, message)
+	default:
+		state, err = waitWithSpinner(tracked, message)
+	}
+	failureDetails := ""
+	if state == "FAILED" && lastTask != nil {
+		failureDetails = ybm.TaskFailureDetails(*lastTask)
+		if failureDetails != "" && viper.GetString("progress") != "json" {
+			fmt.Fprintf(os.Stderr, "Task %s failed: %s\n", lastTask.Info.GetId(), failureDetails)
+		}
+	}
+	sendNotifications(waitOutcome(subject, lastTask, state, err, failureDetails))
+	return state, err
+}
+
+// waitOutcome completes subject with the outcome of a wait
+func waitOutcome(subject notify.Event, task *ybmclient.TaskData, state string, err error, failureDetails string) notify.Event {
+	subject.FinishedAt = time.Now()
+	subject.Duration = subject.FinishedAt.Sub(subject.StartedAt).Seconds()
+	if task != nil {
+		subject.TaskID = task.Info.GetId()
+		subject.TaskType = string(task.Info.GetTaskType())
+		subject.EntityType = string(task.Info.GetEntityType())
+		subject.EntityID = task.Info.GetEntityId()
+	}
+	switch {
+	case errors.Is(err, ybm.ErrWaitTimeout):
+		subject.State = "TIMEOUT"
+	case errors.Is(err, ybm.ErrInterrupted):
+		subject.State = "INTERRUPTED"
+	case err != nil:
+		subject.State = "ERROR"
+		subject.Error = err.Error()
+	default:
+		subject.State = state
+		subject.Error = failureDetails
+	}
+	return subject
+}
+
+// NotifyTargets returns the --notify targets, given on the command line, in the
+// config file or as a single target in YBM_NOTIFY
+func NotifyTargets() []string {
+	switch targets := viper.Get("notify").(type) {
+	case string:
+		if strings.TrimSpace(targets) == "" {
+			return nil
+		}
+		return []string{targets}
+	case []string:
+		return targets
+	case []interface{}:
+		values := []string{}
+		for _, target := range targets {
+			values = append(values, fmt.Sprint(target))
 		}
+		return values
 	}
+	return nil
+}
 
+// sendNotifications notifies the --notify targets, a failed notification is
+// only a warning
+func sendNotifications(event notify.Event) {
+	targetList := NotifyTargets()
+	// Nothing happened with --dry-run, there is nothing to notify
+	if len(targetList) == 0 || DryRun() {
+		return
+	}
+	httpClient, err := NewHTTPClient()
+	if err != nil {
+		logrus.Warnf("Could not send notifications: %s", err)
+		return
+	}
+	targets, err := notify.ParseTargets(targetList, httpClient)
+	if err != nil {
+		logrus.Warnf("Could not send notifications: %s", err)
+		return
+	}
+	for _, err := range notify.Send(targets, event) {
+		logrus.Warn(err)
+	}
 }
 
-func (a *AuthApiClient) WaitForTaskCompletionFull(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, completionStatus []string, message string) (string, error) {
-	var taskList ybmclient.TaskListResponse
-	var resp *http.Response
-	var err error
+// waitWithJsonEvents writes one JSON event per line to out every time the state
+// or the completion of an action changes
+func waitWithJsonEvents(wait taskWaiter, out io.Writer) (string, error) {
+	encoder := json.NewEncoder(out)
+	var last *ybm.TaskEvent
+	return wait(func(task *ybmclient.TaskData, state string) {
+		event := ybm.NewTaskEvent(task, state)
+		if last != nil && last.SameProgress(event) {
+			return
+		}
+		last = &event
+		if err := encoder.Encode(event); err != nil {
+			logrus.Debugf("Unable to write the task event: %v", err)
+		}
+	})
+}
 
+func waitWithStatusLines(wait taskWaiter, completionStatus []string, message string) (string, error) {
 	currentStatus := "UNKNOWN"
-	output := fmt.Sprintf(" %s: %s", message, currentStatus)
-	s := spinner.New(spinner.CharSets[36], 300*time.Millisecond)
+	fmt.Fprintln(os.Stderr, fmt.Sprintf(" %s: %s", message, currentStatus))
+	return wait(func(task *ybmclient.TaskData, state string) {
+		if state != currentStatus {
+			currentStatus = state
+			if !slices.Contains(completionStatus, state) {
+				fmt.Fprintln(os.Stderr, taskProgress(message, task, state))
+			}
+		}
+	})
+}
+
+func waitWithSpinner(wait taskWaiter, message string) (string, error) {
+	output := fmt.Sprintf(" %s: %s", message, "UNKNOWN")
+	s := spinner.New(spinner.CharSets[36], 300*time.Millisecond, spinner.WithWriter(os.Stderr))
 	s.Color("green", "bold")
 	// start animating the spinner
 	s.Start()
 	s.Suffix = " " + output
 	s.FinalMSG = ""
 	defer s.Stop()
-	timeout := time.After(viper.GetDuration("timeout"))
-	checkEveryInSec := time.Tick(10 * time.Second)
-
-	for {
-		select {
-		case <-timeout:
-			s.Stop()
-			return "", fmt.Errorf("wait timeout, operation could still be on-going")
-		case <-a.ctx.Done():
-			s.Stop()
-			return "", fmt.Errorf("receive interrupt signal, operation could still be on-going")
-		case <-checkEveryInSec:
-			apiRequest := a.ListTasks().TaskType(taskType).ProjectId(a.ProjectID).EntityId(entityId).Limit(1)
-			//Sometimes the api do not need any entity type, for example VPC, VPC_PEERING
-			if len(entityType) > 0 {
-				apiRequest.EntityType(entityType)
-			}
-			taskList, resp, err = apiRequest.Execute()
-			if err != nil {
-				logrus.Debugf("Full HTTP response: %v", resp)
-				return "", fmt.Errorf(GetApiErrorDetails(err))
-			}
 
-			if v, ok := taskList.GetDataOk(); ok && v != nil {
-				c := taskList.GetData()
-				if len(c) > 0 {
-					if status, ok := c[0].GetInfoOk(); ok {
-						currentStatus = status.GetState()
-					}
-					output = fmt.Sprintf(" %s: %s", message, currentStatus)
-					if taskProgressInfo, _ := c[0].Info.GetTaskProgressInfoOk(); ok && taskProgressInfo != nil {
+	return wait(func(task *ybmclient.TaskData, state string) {
+		s.Suffix = taskProgress(message, task, state)
+	})
+}
 
-						for index, action := range taskProgressInfo.GetActions() {
-							output = output + "\n" + ". Task " + strconv.Itoa(index+1) + ": " + action.GetName() + " " + strconv.Itoa(int(action.GetPercentComplete())) + "% completed"
-						}
-					}
-				} else {
-					currentStatus = "SUCCEEDED"
-				}
-			}
-			s.Suffix = output
-			if slices.Contains(completionStatus, currentStatus) {
-				return currentStatus, nil
-			}
+// entityTaskWaiter waits with the SDK for the latest task of taskType on the entity
+func (a *AuthApiClient) entityTaskWaiter(entityId string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum, completionStatus []string) taskWaiter {
+	return func(onPoll func(task *ybmclient.TaskData, state string)) (string, error) {
+		// No task was started by --dry-run
+		if DryRun() {
+			return "SUCCEEDED", nil
 		}
+		opts := a.waitOptions(completionStatus, onPoll)
+		opts.EntityType = entityType
+		state, err := a.SDK().WaitForTask(a.ctx, entityId, taskType, opts)
+		return state, waitError(err)
 	}
+}
 
+// waitOptions polls as set by pollOptions until the task reaches one of completionStatus
+func (a *AuthApiClient) waitOptions(completionStatus []string, onPoll func(task *ybmclient.TaskData, state string)) ybm.WaitOptions {
+	return ybm.WaitOptions{
+		PollOptions:      GetPollOptions(),
+		CompletionStates: completionStatus,
+		OnPoll:           onPoll,
+	}
+}
+
+// GetPollOptions polls as set by --poll-interval, --poll-backoff and
+// --poll-max-interval until --timeout
+func GetPollOptions() ybm.PollOptions {
+	return ybm.PollOptions{
+		Interval:    viper.GetDuration("poll-interval"),
+		Backoff:     viper.GetFloat64("poll-backoff"),
+		MaxInterval: viper.GetDuration("poll-max-interval"),
+		Timeout:     viper.GetDuration("timeout"),
+	}
+}
+
+// waitError keeps the timeout and interrupt errors as is and details API errors
+func waitError(err error) error {
+	if err != nil && !errors.Is(err, ybm.ErrWaitTimeout) && !errors.Is(err, ybm.ErrInterrupted) {
+		return fmt.Errorf("%s", GetApiErrorDetails(err))
+	}
+	return err
+}
+
+// taskProgress describes the state of a task and the progress of each of its actions
+func taskProgress(message string, task *ybmclient.TaskData, state string) string {
+	output := fmt.Sprintf(" %s: %s", message, state)
+	if task == nil {
+		return output
+	}
+	if taskProgressInfo, ok := task.Info.GetTaskProgressInfoOk(); ok && taskProgressInfo != nil {
+		for index, action := range taskProgressInfo.GetActions() {
+			output = output + "\n" + ". Task " + strconv.Itoa(index+1) + ": " + action.GetName() + " " + strconv.Itoa(int(action.GetPercentComplete())) + "% completed"
+		}
+	}
+	return output
 }
 
 func getFromNodeConfig(resource string, numCores int32, nodeConfigList []ybmclient.NodeConfigurationResponseItem) (int32, error) {
@@ -1472,15 +1320,8 @@ func getAPIError(b []byte) *ybmclient.ApiError {
 func ParseURL(host string) (*url.URL, error) {
 	if strings.HasPrefix(strings.ToLower(host), "http://") {
 		logrus.Warnf("you are using insecure api endpoint %s\n", host)
-	} else if !strings.HasPrefix(strings.ToLower(host), "https://") {
-		host = "https://" + host
-	}
-
-	endpoint, err := url.ParseRequestURI(host)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse ybm server url (%s): %w", host, err)
 	}
-	return endpoint, err
+	return ybm.ParseURL(host)
 }
 
 func (a *AuthApiClient) CreateMetricsExporterConfig() ybmclient.ApiCreateMetricsExporterConfigRequest {
@@ -1540,37 +1381,15 @@ func (a *AuthApiClient) UpdateIntegration(configId string) ybmclient.ApiUpdateTe
 }
 
 func (a *AuthApiClient) GetConfigByName(configName string) (*ybmclient.MetricsExporterConfigurationData, error) {
-	resp, r, err := a.ListMetricsExporterConfigs().Execute()
-
-	if err != nil {
-		logrus.Debugf("Full HTTP response: %v", r)
-		return nil, err
-	}
-
-	for _, metricsExporter := range resp.Data {
-		if metricsExporter.GetSpec().Name == configName {
-			return &metricsExporter, nil
-		}
-	}
-
-	return nil, fmt.Errorf("could not find config with name %s", configName)
+	config, resp, err := a.SDK().MetricsExporterConfigByName(a.ctx, lookupKey(ybm.MetricsExporters, configName))
+	debugResponse(resp, err)
+	return config, err
 }
 
 func (a *AuthApiClient) GetIntegrationByName(configName string) (*ybmclient.TelemetryProviderData, error) {
-	resp, r, err := a.ListIntegrations().Execute()
-
-	if err != nil {
-		logrus.Debugf("Full HTTP response: %v", r)
-		return nil, err
-	}
-
-	for _, tp := range resp.Data {
-		if tp.GetSpec().Name == configName {
-			return &tp, nil
-		}
-	}
-
-	return nil, fmt.Errorf("could not find config with name %s", configName)
+	integration, resp, err := a.SDK().IntegrationByName(a.ctx, lookupKey(ybm.Integrations, configName))
+	debugResponse(resp, err)
+	return integration, err
 }
 
 func (a *AuthApiClient) GetClusterNamespaces(clusterID string) ybmclient.ApiGetClusterNamespacesRequest {
@@ -1636,19 +1455,9 @@ func (a *AuthApiClient) RemoveDbQueryLoggingConfig(clusterId string, exporterCon
 	return a.ApiClient.ClusterApi.RemovePgLogExporterConfig(a.ctx, a.AccountID, a.ProjectID, clusterId, exporterConfigId)
 }
 
+// GetIntegrationIdFromName accepts an integration name or ID
 func (authApi *AuthApiClient) GetIntegrationIdFromName(integrationName string) (string, error) {
-	integration, _, err := authApi.ListIntegrations().Name(integrationName).Execute()
-	if err != nil {
-		return "", fmt.Errorf("failed to get integration by name %s: %w", integrationName, err)
-	}
-
-	integrationData := integration.GetData()
-
-	if len(integrationData) == 0 {
-		return "", fmt.Errorf("no integrations found with name: %s%s", integrationName, "\n")
-	}
-
-	return integrationData[0].GetInfo().Id, nil
+	return authApi.SDK().IntegrationID(authApi.ctx, lookupKey(ybm.Integrations, integrationName))
 }
 
 func (authApi *AuthApiClient) GetIntegrationNameFromId(integrationId string) (string, error) {
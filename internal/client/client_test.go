@@ -16,6 +16,10 @@
 package client_test
 
 import (
+	"net/http"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -44,4 +48,61 @@ var _ = Describe("Client", func() {
 			Expect(url.String()).To(Equal("https://myurl.com"))
 		})
 	})
+	Context("When building the HTTP transport", func() {
+		It("should use the explicit proxy and default to http scheme", func() {
+			transport, err := client.NewTransport(client.TransportConfig{Proxy: "proxy.corp:3128"})
+			Expect(err).ToNot(HaveOccurred())
+			req, _ := http.NewRequest(http.MethodGet, "https://cloud.yugabyte.com", nil)
+			proxyURL, err := transport.Proxy(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proxyURL.String()).To(Equal("http://proxy.corp:3128"))
+		})
+		It("should fail when the CA bundle does not exist", func() {
+			_, err := client.NewTransport(client.TransportConfig{CABundle: filepath.Join(GinkgoT().TempDir(), "missing.pem")})
+			Expect(err).To(MatchError(ContainSubstring("could not read CA bundle")))
+		})
+		It("should fail when the CA bundle has no certificates", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "bundle.pem")
+			Expect(os.WriteFile(path, []byte("not a certificate"), 0600)).To(Succeed())
+			_, err := client.NewTransport(client.TransportConfig{CABundle: path})
+			Expect(err).To(MatchError(ContainSubstring("no valid PEM certificates")))
+		})
+		It("should skip TLS verification only when requested", func() {
+			transport, err := client.NewTransport(client.TransportConfig{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transport.TLSClientConfig.InsecureSkipVerify).To(BeFalse())
+			transport, err = client.NewTransport(client.TransportConfig{InsecureSkipTLSVerify: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transport.TLSClientConfig.InsecureSkipVerify).To(BeTrue())
+		})
+	})
+	Context("When paginating a list", func() {
+		pages := map[string][]int{"": {1, 2}, "t1": {3, 4}, "t2": {5}}
+		next := map[string]string{"": "t1", "t1": "t2", "t2": ""}
+		var sizes []int32
+		fetch := func(token string, pageSize int32) ([]int, string, *http.Response, error) {
+			sizes = append(sizes, pageSize)
+			return pages[token], next[token], nil, nil
+		}
+		BeforeEach(func() {
+			sizes = nil
+		})
+		It("should return only the first page by default", func() {
+			records, _, err := client.Paginate(client.PageOptions{}, fetch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]int{1, 2}))
+			Expect(sizes).To(Equal([]int32{client.DefaultPageSize}))
+		})
+		It("should follow every continuation token with all", func() {
+			records, _, err := client.Paginate(client.AllPages, fetch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]int{1, 2, 3, 4, 5}))
+		})
+		It("should stop once the limit is reached", func() {
+			records, _, err := client.Paginate(client.PageOptions{Limit: 3, PageSize: 2}, fetch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]int{1, 2, 3}))
+			Expect(sizes).To(Equal([]int32{2, 2}))
+		})
+	})
 })
@@ -0,0 +1,291 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+)
+
+// CompletionCacheTTL keeps completion candidates long enough for repeated tab presses
+const CompletionCacheTTL = time.Minute
+
+// Candidate is a resource offered by shell completion
+type Candidate struct {
+	Name        string `json:"name"`
+	Id          string `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// candidateLister lists the candidates of a resource. Resources that live in a
+// cluster read the cluster from the flags of cmd and return nil without one.
+type candidateLister func(a *AuthApiClient, cmd *cobra.Command) ([]Candidate, error)
+
+type completionResource struct {
+	list candidateLister
+	// byId completes the ID of the resource, with its name as description
+	byId bool
+	// clusterScoped resources are cached per cluster
+	clusterScoped bool
+}
+
+var completionResources = map[string]completionResource{
+	"clusters":            {list: listNames(ybm.Clusters)},
+	"roles":               {list: listNames(ybm.Roles)},
+	"api-keys":            {list: listNames(ybm.ApiKeys)},
+	"integrations":        {list: listNames(ybm.Integrations)},
+	"metrics-exporters":   {list: listNames(ybm.MetricsExporters)},
+	"dr-configs":          {list: listNames(ybm.DrConfigs)},
+	"vpcs":                {list: listNames(ybm.Vpcs)},
+	"network-allow-lists": {list: listNames(ybm.NetworkAllowLists)},
+	"cdc-sinks":           {list: listNames(ybm.CdcSinks)},
+	"cdc-streams":         {list: listNames(ybm.CdcStreams)},
+	"nodes": {clusterScoped: true, list: func(a *AuthApiClient, cmd *cobra.Command) ([]Candidate, error) {
+		clusterId, err := completionClusterId(a, cmd)
+		if clusterId == "" || err != nil {
+			return nil, err
+		}
+		resp, _, err := a.GetClusterNode(clusterId).Execute()
+		candidates := []Candidate{}
+		for _, node := range resp.GetData() {
+			candidates = append(candidates, Candidate{Name: node.GetName()})
+		}
+		return candidates, err
+	}},
+	"endpoints": {byId: true, clusterScoped: true, list: func(a *AuthApiClient, cmd *cobra.Command) ([]Candidate, error) {
+		clusterName := completionClusterName(cmd)
+		if clusterName == "" {
+			return nil, nil
+		}
+		endpoints, _, err := a.GetEndpointsForClusterByName(clusterName)
+		candidates := []Candidate{}
+		for _, endpoint := range endpoints {
+			id := endpoint.GetPseId()
+			if id == "" {
+				id = endpoint.GetId()
+			}
+			candidates = append(candidates, Candidate{Id: id, Description: string(endpoint.GetAccessibilityType()) + " " + endpoint.GetRegion()})
+		}
+		return candidates, err
+	}},
+	"backups": {byId: true, clusterScoped: true, list: func(a *AuthApiClient, cmd *cobra.Command) ([]Candidate, error) {
+		request := a.ListBackups()
+		clusterId, err := completionClusterId(a, cmd)
+		if err != nil {
+			return nil, err
+		}
+		if clusterId != "" {
+			request = request.ClusterId(clusterId)
+		}
+		backups, _, err := a.ListBackupsPaged(request, AllPages)
+		candidates := []Candidate{}
+		for _, backup := range backups {
+			info := backup.GetInfo()
+			candidates = append(candidates, Candidate{Id: info.GetId(), Description: info.GetClusterName()})
+		}
+		return candidates, err
+	}},
+}
+
+// ownNameFlags is the flag naming the resource created by each create command,
+// nothing exists yet to complete it with
+var ownNameFlags = map[string]string{
+	"cluster":          "cluster-name",
+	"role":             "role-name",
+	"integration":      "config-name",
+	"metrics-exporter": "config-name",
+}
+
+// completionResourceFor returns the resource completing flag f of c, or an empty string.
+// The boolean is true when f is the ID alternative of a name flag.
+func completionResourceFor(c *cobra.Command, f *pflag.Flag) (string, bool) {
+	if nameFlag, ok := f.Annotations[idForAnnotation]; ok {
+		if named := c.Flags().Lookup(nameFlag[0]); named != nil {
+			resource, _ := completionResourceFor(c, named)
+			return resource, true
+		}
+		return "", false
+	}
+	parent := ""
+	if c.HasParent() {
+		parent = c.Parent().Name()
+	}
+	if c.Name() == "create" && (f.Name == "name" || f.Name == ownNameFlags[parent]) {
+		return "", false
+	}
+
+	switch f.Name {
+	case "cluster-name", "source-cluster", "target-cluster":
+		return "clusters", false
+	case "role-name":
+		return "roles", false
+	case "integration-name":
+		return "integrations", false
+	case "config-name":
+		if parent == "metrics-exporter" || c.Name() == "metrics-exporter" {
+			return "metrics-exporters", false
+		}
+		return "integrations", false
+	case "config":
+		if strings.HasPrefix(c.CommandPath(), c.Root().Name()+" dr ") {
+			return "dr-configs", false
+		}
+	case "yb-vpc-name":
+		return "vpcs", false
+	case "network-allow-list":
+		return "network-allow-lists", false
+	case "sink":
+		return "cdc-sinks", false
+	case "node-name":
+		return "nodes", false
+	case "endpoint-id":
+		return "endpoints", false
+	case "backup-id":
+		return "backups", false
+	case "name":
+		switch parent {
+		case "vpc":
+			return "vpcs", false
+		case "network-allow-list":
+			return "network-allow-lists", false
+		case "sink":
+			return "cdc-sinks", false
+		case "stream":
+			return "cdc-streams", false
+		case "api-key":
+			return "api-keys", false
+		}
+	}
+	return "", false
+}
+
+// RegisterCompletions walks the command tree and registers a completion function
+// querying the API for every flag naming an existing resource
+func RegisterCompletions(root *cobra.Command) {
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c != root {
+			c.LocalFlags().VisitAll(func(f *pflag.Flag) {
+				resource, idFlag := completionResourceFor(c, f)
+				if resource == "" {
+					return
+				}
+				if err := c.RegisterFlagCompletionFunc(f.Name, completeResource(resource, idFlag)); err != nil {
+					logrus.Debugf("Unable to register completion for --%s of %s: %v", f.Name, c.CommandPath(), err)
+				}
+			})
+		}
+		for _, child := range c.Commands() {
+			walk(child)
+		}
+	}
+	walk(root)
+}
+
+func completeResource(resource string, idFlag bool) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		candidates, err := listCandidates(cmd, resource)
+		if err != nil {
+			cobra.CompDebugln(err.Error(), false)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		byId := idFlag || completionResources[resource].byId
+		completions := []string{}
+		for _, candidate := range candidates {
+			value, description := candidate.Name, candidate.Description
+			if byId {
+				value = candidate.Id
+				if description == "" {
+					description = candidate.Name
+				}
+			}
+			if value == "" || !strings.HasPrefix(value, toComplete) {
+				continue
+			}
+			if description != "" {
+				value += "\t" + description
+			}
+			completions = append(completions, value)
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// listCandidates lists the candidates of resource through the on-disk cache,
+// completion runs a new process on every tab press
+func listCandidates(cmd *cobra.Command, resource string) ([]Candidate, error) {
+	if viper.GetString("apiKey") == "" {
+		return nil, nil
+	}
+	a, err := NewAuthApiClient()
+	if err != nil {
+		return nil, err
+	}
+	if a.AccountID, err = a.GetAccountID(""); err != nil {
+		return nil, err
+	}
+	if a.ProjectID, err = a.GetProjectID(""); err != nil {
+		return nil, err
+	}
+
+	completion := completionResources[resource]
+	key := []string{"completion", a.ProjectID, resource}
+	if completion.clusterScoped {
+		key = append(key, completionClusterName(cmd))
+	}
+	candidates, _, err := cached(a, CompletionCacheTTL, key, func() ([]Candidate, *http.Response, error) {
+		candidates, err := completion.list(a, cmd)
+		return candidates, nil, err
+	})
+	return candidates, err
+}
+
+// completionClusterName returns the cluster given on the command line so far
+func completionClusterName(cmd *cobra.Command) string {
+	for _, flag := range []string{"cluster-id", "cluster-name"} {
+		if value, err := cmd.Flags().GetString(flag); err == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func completionClusterId(a *AuthApiClient, cmd *cobra.Command) (string, error) {
+	clusterName := completionClusterName(cmd)
+	if clusterName == "" {
+		return "", nil
+	}
+	return a.GetClusterIdByName(clusterName)
+}
+
+// listNames lists the resources of kind that are not scoped to a cluster
+func listNames(kind ybm.ResourceKind) candidateLister {
+	return func(a *AuthApiClient, _ *cobra.Command) ([]Candidate, error) {
+		refs, err := a.SDK().Names(a.ctx, kind)
+		candidates := make([]Candidate, 0, len(refs))
+		for _, ref := range refs {
+			candidates = append(candidates, Candidate{Name: ref.Name, Id: ref.ID})
+		}
+		return candidates, err
+	}
+}
@@ -0,0 +1,140 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/redact"
+)
+
+// DryRun reports whether --dry-run is set, in which case no change is sent to the API
+func DryRun() bool {
+	return viper.GetBool("dry-run")
+}
+
+// DryRunRequest is the request a command would have sent, as printed by --dry-run
+type DryRunRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// dryRunTransport sends the requests reading the API, so that names still
+// resolve to IDs, and prints every request changing it instead of sending it,
+// answering with an empty response. The command then goes on as if the
+// request succeeded, its own output discarded, so that a command such as
+// apply, cluster clone or a pause of several clusters shows all its requests.
+// The IDs of the resources it would have created are empty in the requests
+// using them.
+type dryRunTransport struct {
+	next http.RoundTripper
+}
+
+var (
+	dryRunMu sync.Mutex
+	// dryRunOutput is the stdout of the process, the command output going to
+	// the null device once a request is printed
+	dryRunOutput  io.Writer
+	dryRunCount   int
+	dryRunDone    sync.Once
+	dryRunHandler sync.Once
+)
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+
+	request := DryRunRequest{Method: req.Method, Path: req.URL.RequestURI()}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = dryRunBody(body)
+	}
+	data, err := json.MarshalIndent(request, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	dryRunMu.Lock()
+	defer dryRunMu.Unlock()
+	if dryRunOutput == nil {
+		dryRunOutput = os.Stdout
+		if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+			os.Stdout = devNull
+		}
+	}
+	fmt.Fprintln(dryRunOutput, string(data))
+	dryRunCount++
+	logrus.Debugf("Dry run of %s %s", req.Method, req.URL.Path)
+	// An empty body decodes to an empty response
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+// EndDryRun tells on stderr how many requests --dry-run did not send, once
+func EndDryRun() {
+	if !DryRun() {
+		return
+	}
+	dryRunDone.Do(func() {
+		dryRunMu.Lock()
+		defer dryRunMu.Unlock()
+		switch dryRunCount {
+		case 0:
+			fmt.Fprintln(os.Stderr, "Dry run, no request would change anything")
+		case 1:
+			fmt.Fprintln(os.Stderr, "Dry run, 1 request was not sent")
+		default:
+			fmt.Fprintf(os.Stderr, "Dry run, %d requests were not sent\n", dryRunCount)
+		}
+	})
+}
+
+// dryRunBody returns the redacted JSON of a request body, or the body as a
+// JSON string when it is not JSON
+func dryRunBody(body []byte) json.RawMessage {
+	redacted := []byte(redact.String(string(body)))
+	if len(bytes.TrimSpace(redacted)) == 0 {
+		return nil
+	}
+	if json.Valid(redacted) {
+		return redacted
+	}
+	quoted, _ := json.Marshal(string(redacted))
+	return quoted
+}
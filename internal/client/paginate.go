@@ -0,0 +1,144 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// DefaultPageSize is the number of records requested per API call
+const DefaultPageSize = ybm.DefaultPageSize
+
+// PageOptions controls how many records a list call returns
+type PageOptions = ybm.PageOptions
+
+// AllPages is used by the lookup helpers, which must see every record to find a match
+var AllPages = ybm.AllPages
+
+// PageFetcher requests a single page. The token is empty for the first page,
+// the returned token is empty once the last page has been reached.
+type PageFetcher[T any] func(token string, pageSize int32) ([]T, string, *http.Response, error)
+
+// AddPaginationFlags adds --limit, --page-size and --all to a list command
+func AddPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("limit", 0, "[OPTIONAL] Maximum number of records to return, fetching as many pages as needed.")
+	cmd.Flags().Int("page-size", DefaultPageSize, "[OPTIONAL] Number of records requested per API call.")
+	cmd.Flags().Bool("all", false, "[OPTIONAL] Fetch every page of results.")
+}
+
+// GetPageOptions reads the pagination flags of a list command
+func GetPageOptions(cmd *cobra.Command) PageOptions {
+	limit, _ := cmd.Flags().GetInt("limit")
+	pageSize, _ := cmd.Flags().GetInt("page-size")
+	all, _ := cmd.Flags().GetBool("all")
+	return PageOptions{Limit: limit, PageSize: pageSize, All: all}
+}
+
+// Paginate calls fetch until opts are satisfied. Without --all or --limit only the
+// first page is returned, with a warning when more records are available.
+func Paginate[T any](opts PageOptions, fetch PageFetcher[T]) ([]T, *http.Response, error) {
+	records, more, r, err := ybm.Paginate(opts, ybm.PageFetcher[T](fetch))
+	if more {
+		logrus.Warnf("Showing the first %d results, more are available. Use --all or --limit to fetch them.\n", len(records))
+	}
+	return records, r, err
+}
+
+func (a *AuthApiClient) ListClustersPaged(request ybmclient.ApiListClustersRequest, opts PageOptions) ([]ybmclient.ClusterData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.ClusterData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListBackupsPaged(request ybmclient.ApiListBackupsRequest, opts PageOptions) ([]ybmclient.BackupData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.BackupData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListAccountUsersPaged(request ybmclient.ApiListAccountUsersRequest, opts PageOptions) ([]ybmclient.UserData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.UserData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListApiKeysPaged(request ybmclient.ApiListApiKeysRequest, opts PageOptions) ([]ybmclient.ApiKeyData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.ApiKeyData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListRbacRolesPaged(request ybmclient.ApiListRbacRolesRequest, opts PageOptions) ([]ybmclient.RoleData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.RoleData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListCdcSinksPaged(request ybmclient.ApiListCdcSinksRequest, opts PageOptions) ([]ybmclient.CdcSinkData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.CdcSinkData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListCdcStreamsPaged(request ybmclient.ApiListCdcStreamsForAccountRequest, opts PageOptions) ([]ybmclient.CdcStreamData, *http.Response, error) {
+	return Paginate(opts, func(token string, pageSize int32) ([]ybmclient.CdcStreamData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+}
+
+func (a *AuthApiClient) ListTasksPaged(filter ybm.TaskFilter, opts PageOptions) ([]ybmclient.TaskData, *http.Response, error) {
+	return Paginate(opts, PageFetcher[ybmclient.TaskData](a.SDK().TaskPages(a.ctx, filter)))
+}
@@ -0,0 +1,161 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+)
+
+// idForAnnotation marks an --<resource>-id flag with the name flag it replaces
+const idForAnnotation = "ybm_id_for"
+
+// IsId returns true when value is a resource ID rather than a name
+func IsId(value string) bool {
+	return ybm.IsId(value)
+}
+
+// NotFoundError is returned by the lookup helpers when no resource has the given name
+type NotFoundError = ybm.NotFoundError
+
+// AddIdFlag adds an --<resource>-id alternative to a name flag of flags, which is
+// either cmd.Flags() or cmd.PersistentFlags(). Both flags are mutually exclusive,
+// and if the name flag was required then one of the two is.
+func AddIdFlag(cmd *cobra.Command, flags *pflag.FlagSet, nameFlag string, idFlag string, resource string) {
+	flags.String(idFlag, "", fmt.Sprintf("[OPTIONAL] The ID of the %s, alternative to --%s.", resource, nameFlag))
+	flags.SetAnnotation(idFlag, idForAnnotation, []string{nameFlag})
+
+	if f := flags.Lookup(nameFlag); f != nil {
+		if required, ok := f.Annotations[cobra.BashCompOneRequiredFlag]; ok && len(required) > 0 && required[0] == "true" {
+			delete(f.Annotations, cobra.BashCompOneRequiredFlag)
+			cmd.MarkFlagsOneRequired(nameFlag, idFlag)
+		}
+	}
+	cmd.MarkFlagsMutuallyExclusive(nameFlag, idFlag)
+}
+
+// idAliases maps, by kind, the name resolved from an --<resource>-id flag to that
+// ID. The lookup helpers use the ID, so that a name shared by several resources
+// is not ambiguous.
+var idAliases = map[ybm.ResourceKind]map[string]string{}
+
+// lookupKey returns the ID given by an --<resource>-id flag for the resource of
+// kind called name, or name
+func lookupKey(kind ybm.ResourceKind, name string) string {
+	if id, ok := idAliases[kind][name]; ok {
+		return id
+	}
+	return name
+}
+
+// ApplyIdFlags sets the name flag of every --<resource>-id flag given on the
+// command line to the name of the resource with that ID, so that commands read
+// the name flag as usual and show the name in their messages. The ID is kept
+// in the name flag when the resource cannot be looked up by name.
+func ApplyIdFlags(cmd *cobra.Command) error {
+	var idFlags []*pflag.Flag
+	var err error
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if _, ok := f.Annotations[idForAnnotation]; !ok || !f.Changed || err != nil {
+			return
+		}
+		if !IsId(f.Value.String()) {
+			err = fmt.Errorf("--%s must be an ID, got '%s'", f.Name, f.Value.String())
+			return
+		}
+		idFlags = append(idFlags, f)
+	})
+	if err != nil || len(idFlags) == 0 {
+		return err
+	}
+
+	var authApi *AuthApiClient
+	for _, f := range idFlags {
+		nameFlag := f.Annotations[idForAnnotation][0]
+		id := f.Value.String()
+		kind := ybm.ResourceKind("")
+		if named := cmd.Flags().Lookup(nameFlag); named != nil {
+			resource, _ := completionResourceFor(cmd, named)
+			kind = ybm.ResourceKind(resource)
+		}
+		if !resolvesNames(kind) {
+			if err := cmd.Flags().Set(nameFlag, id); err != nil {
+				return err
+			}
+			continue
+		}
+		if authApi == nil {
+			if authApi, err = NewAuthApiClient(); err != nil {
+				return err
+			}
+			authApi.GetInfo("", "")
+		}
+		name, err := authApi.nameOfId(kind, id)
+		if err != nil {
+			return err
+		}
+		if idAliases[kind] == nil {
+			idAliases[kind] = map[string]string{}
+		}
+		idAliases[kind][name] = id
+		if err := cmd.Flags().Set(nameFlag, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvesNames tells whether the resources of kind are looked up by name
+func resolvesNames(kind ybm.ResourceKind) bool {
+	switch kind {
+	case ybm.Clusters, ybm.Roles, ybm.ApiKeys, ybm.Integrations, ybm.MetricsExporters, ybm.DrConfigs,
+		ybm.Vpcs, ybm.NetworkAllowLists, ybm.CdcSinks, ybm.CdcStreams:
+		return true
+	}
+	return false
+}
+
+// nameOfId returns the name of the resource of kind with the given ID
+func (a *AuthApiClient) nameOfId(kind ybm.ResourceKind, id string) (string, error) {
+	switch kind {
+	case ybm.Clusters:
+		cluster, err := a.GetClusterByName(id)
+		if err != nil {
+			return "", fmt.Errorf("%s", strings.TrimSpace(GetApiErrorDetails(err)))
+		}
+		return cluster.Spec.Name, nil
+	case ybm.Roles:
+		role, err := a.GetRoleByName(id)
+		if err != nil {
+			return "", fmt.Errorf("%s", strings.TrimSpace(GetApiErrorDetails(err)))
+		}
+		return role.Info.GetDisplayName(), nil
+	}
+	refs, err := a.SDK().Names(a.ctx, kind)
+	if err != nil {
+		return "", fmt.Errorf("%s", strings.TrimSpace(GetApiErrorDetails(err)))
+	}
+	for _, ref := range refs {
+		if ref.ID == id {
+			return ref.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no %s found with ID %s", strings.TrimSuffix(strings.ReplaceAll(string(kind), "-", " "), "s"), id)
+}
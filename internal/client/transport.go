@@ -0,0 +1,150 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/trace"
+)
+
+var (
+	insecureWarning sync.Once
+	traceOnce       sync.Once
+	traceRecorder   *trace.Recorder
+	traceErr        error
+)
+
+// TransportConfig holds the network settings applied to every outgoing HTTP call
+type TransportConfig struct {
+	Proxy                 string
+	CABundle              string
+	InsecureSkipTLSVerify bool
+}
+
+// GetTransportConfig reads the transport settings from flags, environment or config file
+func GetTransportConfig() TransportConfig {
+	return TransportConfig{
+		Proxy:                 viper.GetString("proxy"),
+		CABundle:              viper.GetString("ca-bundle"),
+		InsecureSkipTLSVerify: viper.GetBool("insecure-skip-tls-verify"),
+	}
+}
+
+// NewHTTPClient returns an http.Client honouring the proxy, CA bundle and TLS settings
+func NewHTTPClient() (*http.Client, error) {
+	transport, err := NewTransport(GetTransportConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// GetTraceRecorder returns the process wide recorder for --trace-file, or nil when tracing is off
+func GetTraceRecorder() (*trace.Recorder, error) {
+	path := viper.GetString("trace-file")
+	if strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+	traceOnce.Do(func() {
+		traceRecorder, traceErr = trace.NewRecorder(path, cliVersion)
+		if traceErr == nil {
+			logrus.Debugf("Recording HTTP trace to %s", path)
+			// logrus.Fatal skips the post run of the command
+			logrus.RegisterExitHandler(CloseTraceRecorder)
+		}
+	})
+	return traceRecorder, traceErr
+}
+
+// CloseTraceRecorder writes out the trace of --trace-file, when tracing is on
+func CloseTraceRecorder() {
+	if traceRecorder == nil {
+		return
+	}
+	if err := traceRecorder.Close(); err != nil {
+		logrus.Warnf("Unable to write HTTP trace: %v\n", err)
+	}
+}
+
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if strings.TrimSpace(config.Proxy) != "" {
+		proxyURL, err := parseProxyURL(config.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if strings.TrimSpace(config.CABundle) != "" {
+		pool, err := loadCABundle(config.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.InsecureSkipTLSVerify {
+		insecureWarning.Do(func() {
+			logrus.Warnln("TLS certificate verification is disabled (--insecure-skip-tls-verify). Connections can be intercepted, use it only against lab hosts.")
+		})
+		tlsConfig.InsecureSkipVerify = true
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func parseProxyURL(proxy string) (*url.URL, error) {
+	if !strings.Contains(proxy, "://") {
+		proxy = "http://" + proxy
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse proxy url (%s): %w", proxy, err)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("could not parse proxy url (%s): missing host", proxy)
+	}
+	return proxyURL, nil
+}
+
+func loadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA bundle %s: %w", path, err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		logrus.Debugf("Unable to load system cert pool, using only %s: %v", path, err)
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid PEM certificates found in CA bundle %s", path)
+	}
+	return pool, nil
+}
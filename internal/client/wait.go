@@ -0,0 +1,240 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/notify"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+	"golang.org/x/term"
+)
+
+// TaskWait is one of the tasks waited for by WaitForTasks
+type TaskWait struct {
+	// Name of the entity in the progress lines and the summary
+	Name       string
+	EntityId   string
+	EntityType ybmclient.EntityTypeEnum
+	TaskType   ybmclient.TaskTypeEnum
+}
+
+// TaskWaitResult is the outcome of a TaskWait. State is the final state of the
+// task, or TIMEOUT, INTERRUPTED or ERROR when the wait itself did not complete.
+type TaskWaitResult struct {
+	TaskWait
+	State    string
+	Error    string
+	Duration time.Duration
+}
+
+// Succeeded is true when the task reached the SUCCEEDED state
+func (r TaskWaitResult) Succeeded() bool {
+	return r.State == "SUCCEEDED"
+}
+
+// AllTasksSucceeded is true when every task reached the SUCCEEDED state
+func AllTasksSucceeded(results []TaskWaitResult) bool {
+	for _, result := range results {
+		if !result.Succeeded() {
+			return false
+		}
+	}
+	return true
+}
+
+// WaitForTasks waits for several tasks at once with one progress line per entity.
+// --timeout bounds the whole wait, the tasks still running then are TIMEOUT.
+// Every task is reported to the --notify targets as it completes.
+func (a *AuthApiClient) WaitForTasks(waits []TaskWait, completionStatus []string) []TaskWaitResult {
+	ctx := a.ctx
+	if timeout := viper.GetDuration("timeout"); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	pollOptions := GetPollOptions()
+	// The context enforces the overall timeout
+	pollOptions.Timeout = 0
+
+	progress := newMultiProgress(waits, os.Stderr)
+	progress.start()
+	results := make([]TaskWaitResult, len(waits))
+	var wg sync.WaitGroup
+	for i, wait := range waits {
+		wg.Add(1)
+		go func(i int, wait TaskWait) {
+			defer wg.Done()
+			var lastTask *ybmclient.TaskData
+			subject := notify.Event{EntityType: string(wait.EntityType), EntityID: wait.EntityId, TaskType: string(wait.TaskType), StartedAt: time.Now()}
+			state, err := a.SDK().WaitForTask(ctx, wait.EntityId, wait.TaskType, ybm.WaitOptions{
+				PollOptions:      pollOptions,
+				EntityType:       wait.EntityType,
+				CompletionStates: completionStatus,
+				OnPoll: func(task *ybmclient.TaskData, state string) {
+					lastTask = task
+					progress.update(i, task, state)
+				},
+			})
+			err = waitError(err)
+			failureDetails := ""
+			if state == "FAILED" && lastTask != nil {
+				failureDetails = ybm.TaskFailureDetails(*lastTask)
+			}
+			outcome := waitOutcome(subject, lastTask, state, err, failureDetails)
+			results[i] = TaskWaitResult{
+				TaskWait: wait,
+				State:    outcome.State,
+				Error:    outcome.Error,
+				Duration: outcome.FinishedAt.Sub(outcome.StartedAt),
+			}
+			progress.done(i, results[i])
+			sendNotifications(outcome)
+		}(i, wait)
+	}
+	wg.Wait()
+	progress.stop()
+	return results
+}
+
+// multiProgress shows the progress of several tasks: one JSON event per change
+// with --progress json, redrawn lines on a terminal, and a line per change
+// otherwise or when YBM_CI is true
+type multiProgress struct {
+	mu      sync.Mutex
+	out     io.Writer
+	mode    string
+	names   []string
+	lines   []string
+	events  []*ybm.TaskEvent
+	drawn   int
+	stopped chan struct{}
+	ticker  *time.Ticker
+}
+
+func newMultiProgress(waits []TaskWait, out *os.File) *multiProgress {
+	p := &multiProgress{out: out, mode: "lines", stopped: make(chan struct{})}
+	switch {
+	case viper.GetString("progress") == "json":
+		p.mode = "json"
+	case strings.ToLower(os.Getenv("YBM_CI")) != "true" && term.IsTerminal(int(out.Fd())):
+		p.mode = "redraw"
+	}
+	for _, wait := range waits {
+		p.names = append(p.names, wait.Name)
+		p.lines = append(p.lines, fmt.Sprintf(" %s: %s", wait.Name, "UNKNOWN"))
+		p.events = append(p.events, nil)
+	}
+	return p
+}
+
+func (p *multiProgress) start() {
+	if p.mode != "redraw" {
+		return
+	}
+	p.ticker = time.NewTicker(300 * time.Millisecond)
+	go func() {
+		for {
+			select {
+			case <-p.stopped:
+				return
+			case <-p.ticker.C:
+				p.mu.Lock()
+				p.draw()
+				p.mu.Unlock()
+			}
+		}
+	}()
+}
+
+func (p *multiProgress) stop() {
+	if p.mode != "redraw" {
+		return
+	}
+	p.ticker.Stop()
+	close(p.stopped)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.draw()
+}
+
+// draw rewrites the lines in place, the caller holds the lock
+func (p *multiProgress) draw() {
+	if p.drawn > 0 {
+		fmt.Fprintf(p.out, "\033[%dA", p.drawn)
+	}
+	for _, line := range p.lines {
+		fmt.Fprintf(p.out, "\033[2K%s\n", line)
+	}
+	p.drawn = len(p.lines)
+}
+
+func (p *multiProgress) update(i int, task *ybmclient.TaskData, state string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.mode == "json" {
+		event := ybm.NewTaskEvent(task, state)
+		if p.events[i] != nil && p.events[i].SameProgress(event) {
+			return
+		}
+		p.events[i] = &event
+		if err := json.NewEncoder(p.out).Encode(event); err != nil {
+			logrus.Debugf("Unable to write the task event: %v", err)
+		}
+		return
+	}
+	line := fmt.Sprintf(" %s: %s", p.names[i], state)
+	if task != nil {
+		if percent, ok := ybm.TaskPercentComplete(*task); ok {
+			line = fmt.Sprintf("%s %d%%", line, percent)
+		}
+	}
+	p.setLine(i, line)
+}
+
+func (p *multiProgress) done(i int, result TaskWaitResult) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.mode == "json" {
+		return
+	}
+	line := fmt.Sprintf(" %s: %s", p.names[i], result.State)
+	if result.Error != "" {
+		line += " (" + result.Error + ")"
+	}
+	p.setLine(i, line)
+}
+
+// setLine changes the line of a task, the caller holds the lock
+func (p *multiProgress) setLine(i int, line string) {
+	if line == p.lines[i] {
+		return
+	}
+	p.lines[i] = line
+	if p.mode == "lines" {
+		fmt.Fprintln(p.out, line)
+	}
+}
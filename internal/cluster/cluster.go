@@ -16,42 +16,92 @@
 package cluster
 
 import (
+	"sync"
+
 	"github.com/sirupsen/logrus"
 	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
 	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
+// DefaultParallelism is the number of clusters fetched at the same time by NewFullClusters
+const DefaultParallelism = 8
+
 // This struct is an attempt to consilidate Cluster information
 // VPC, NetworkAllowList etc..
 type FullCluster struct {
-	Cluster ybmclient.ClusterData
+	Cluster ybmclient.ClusterData `json:"cluster"`
 	//VPC details "vpcid" => details
-	Vpc map[string]ybmclient.SingleTenantVpcDataResponse
+	Vpc map[string]ybmclient.SingleTenantVpcDataResponse `json:"vpcs"`
 	//AllowList Attach to the cluster
-	AllowList []ybmclient.NetworkAllowListData
+	AllowList []ybmclient.NetworkAllowListData `json:"allow_lists"`
 	//Nodes of the cluster
-	Nodes []ybmclient.NodeData
+	Nodes []ybmclient.NodeData `json:"nodes"`
 	//CMK of the cluster
-	CMK []ybmclient.CMKData
+	CMK []ybmclient.CMKData `json:"cmk"`
 	//Helpful to filter by provider
-	Providers []string
+	Providers []string `json:"providers"`
 }
 
-func NewFullCluster(authApi ybmAuthClient.AuthApiClient, clusterData ybmclient.ClusterData) *FullCluster {
-	fc := &FullCluster{
+func newFullCluster(clusterData ybmclient.ClusterData) *FullCluster {
+	return &FullCluster{
 		Cluster:   clusterData,
 		Vpc:       map[string]ybmclient.SingleTenantVpcDataResponse{},
 		Providers: []string{},
 	}
-	// Add VPC information
-	fc.SetVPCs(authApi)
-	fc.SetAllowLists(authApi)
-	fc.SetNodes(authApi)
-	fc.SetCMK(authApi)
-	fc.SetProviders(authApi)
+}
+
+func NewFullCluster(authApi ybmAuthClient.AuthApiClient, clusterData ybmclient.ClusterData) *FullCluster {
+	fc := newFullCluster(clusterData)
+	fc.SetProviders()
+	runConcurrently(authApi, fc.SetVPCs, fc.SetAllowLists, fc.SetNodes, fc.SetCMK)
 	return fc
 }
 
+// NewFullClusters builds the full view of several clusters, at most parallelism at a time.
+// VPCs are shared between clusters so they are fetched once for the whole list.
+func NewFullClusters(authApi ybmAuthClient.AuthApiClient, clusters []ybmclient.ClusterData, parallelism int) []*FullCluster {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	vpcs := fetchVPCs(authApi, vpcIds(clusters...))
+
+	fullClusters := make([]*FullCluster, len(clusters))
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, clusterData := range clusters {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, clusterData ybmclient.ClusterData) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fc := newFullCluster(clusterData)
+			for _, id := range vpcIds(clusterData) {
+				if vpc, ok := vpcs[id]; ok {
+					fc.Vpc[id] = vpc
+				}
+			}
+			fc.SetProviders()
+			runConcurrently(authApi, fc.SetAllowLists, fc.SetNodes, fc.SetCMK)
+			fullClusters[i] = fc
+		}(i, clusterData)
+	}
+	wg.Wait()
+	return fullClusters
+}
+
+// runConcurrently calls every setter in its own goroutine and waits for all of them
+func runConcurrently(authApi ybmAuthClient.AuthApiClient, setters ...func(ybmAuthClient.AuthApiClient)) {
+	var wg sync.WaitGroup
+	for _, set := range setters {
+		wg.Add(1)
+		go func(set func(ybmAuthClient.AuthApiClient)) {
+			defer wg.Done()
+			set(authApi)
+		}(set)
+	}
+	wg.Wait()
+}
+
 func (f *FullCluster) SetCMK(authApi ybmAuthClient.AuthApiClient) {
 	resp, r, err := authApi.ListClusterCMKs(f.Cluster.Info.Id).Execute()
 	if err != nil {
@@ -69,26 +119,37 @@ func (f *FullCluster) SetCMK(authApi ybmAuthClient.AuthApiClient) {
 	}
 }
 
-func (f *FullCluster) SetProviders(authApi ybmAuthClient.AuthApiClient) {
-	providers, err := authApi.ExtractProviderFromClusterName(f.Cluster.Info.Id)
-	if err != nil {
-		logrus.Fatalf("could not fetch provider for cluster %s : %s\n", f.Cluster.Spec.Name, ybmAuthClient.GetApiErrorDetails(err))
-	}
-	f.Providers = providers
+// SetProviders derives the providers from the cluster regions, no extra API call is needed
+func (f *FullCluster) SetProviders() {
+	f.Providers = ybmAuthClient.ExtractProviders(f.Cluster)
 }
 
 func (f *FullCluster) SetVPCs(authApi ybmAuthClient.AuthApiClient) {
-	var VpcIds []string
-	if _, ok := f.Cluster.GetSpecOk(); ok {
-		for _, v := range f.Cluster.Spec.ClusterRegionInfo {
-			if v.PlacementInfo.VpcId.IsSet() {
-				if len(v.PlacementInfo.GetVpcId()) > 0 {
-					VpcIds = append(VpcIds, v.PlacementInfo.GetVpcId())
+	for id, vpc := range fetchVPCs(authApi, vpcIds(f.Cluster)) {
+		f.Vpc[id] = vpc
+	}
+}
 
+func vpcIds(clusters ...ybmclient.ClusterData) []string {
+	var VpcIds []string
+	seen := map[string]bool{}
+	for _, clusterData := range clusters {
+		if _, ok := clusterData.GetSpecOk(); ok {
+			for _, v := range clusterData.Spec.ClusterRegionInfo {
+				if v.PlacementInfo.VpcId.IsSet() {
+					if id := v.PlacementInfo.GetVpcId(); len(id) > 0 && !seen[id] {
+						seen[id] = true
+						VpcIds = append(VpcIds, id)
+					}
 				}
 			}
 		}
 	}
+	return VpcIds
+}
+
+func fetchVPCs(authApi ybmAuthClient.AuthApiClient, VpcIds []string) map[string]ybmclient.SingleTenantVpcDataResponse {
+	vpcs := map[string]ybmclient.SingleTenantVpcDataResponse{}
 	if len(VpcIds) > 0 {
 		resp, r, err := authApi.ListSingleTenantVpcs().Ids(VpcIds).Execute()
 		if err != nil {
@@ -97,10 +158,11 @@ func (f *FullCluster) SetVPCs(authApi ybmAuthClient.AuthApiClient) {
 		}
 		if _, ok := resp.GetDataOk(); ok {
 			for _, v := range resp.GetData() {
-				f.Vpc[v.Info.Id] = v
+				vpcs[v.Info.Id] = v
 			}
 		}
 	}
+	return vpcs
 }
 
 func (f *FullCluster) SetAllowLists(authApi ybmAuthClient.AuthApiClient) {
@@ -57,6 +57,46 @@ func (c *FullClusterContext) SetFullCluster(authApi client.AuthApiClient, cluste
 	c.fullCluster = fc
 }
 
+// FullClusterListWrite renders the full view of every cluster, one after another for
+// table output or as one record per cluster for the json, pretty and custom formats
+func FullClusterListWrite(ctx Context, fullClusters []*cluster.FullCluster) error {
+	if !ctx.Format.IsTable() {
+		render := func(format func(subContext SubContext) error) error {
+			for _, fc := range fullClusters {
+				if err := format(&fullClusterRecord{FullCluster: fc}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return ctx.Write(&fullClusterRecord{}, render)
+	}
+	for i, fc := range fullClusters {
+		if i > 0 {
+			ctx.Output.Write([]byte("\n\n"))
+		}
+		fullClusterContext := NewFullClusterContext()
+		fullClusterContext.Output = ctx.Output
+		fullClusterContext.Format = ctx.Format
+		fullClusterContext.fullCluster = fc
+		if err := fullClusterContext.Write(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fullClusterRecord is the full view of a cluster as a record, for the json,
+// pretty and custom formats
+type fullClusterRecord struct {
+	HeaderContext
+	*cluster.FullCluster
+}
+
+func (r *fullClusterRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.FullCluster)
+}
+
 func (c *FullClusterContext) startSubsection(format string) (*template.Template, error) {
 	c.buffer = bytes.NewBufferString("")
 	c.header = ""
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"text/template"
 
@@ -36,6 +37,7 @@ const (
 	RawFormatKey    = "raw"
 	PrettyFormatKey = "pretty"
 	JSONFormatKey   = "json"
+	NDJSONFormatKey = "ndjson"
 
 	DefaultQuietFormat = "{{.ID}}"
 	jsonFormat         = "{{json .}}"
@@ -64,9 +66,10 @@ func (f Format) IsTable() bool {
 	return strings.HasPrefix(string(f), TableFormatKey)
 }
 
-// IsJSON returns true if the format is the json format
+// IsJSON returns true if the format is the json format. ndjson is the same
+// format, one record per line, under the name used by streaming tools.
 func (f Format) IsJSON() bool {
-	return string(f) == JSONFormatKey
+	return string(f) == JSONFormatKey || string(f) == NDJSONFormatKey
 }
 
 // IsJSON returns true if the format is the json format
@@ -166,6 +169,12 @@ func (c *Context) Write(sub SubContext, f SubFormat) error {
 	return nil
 }
 
+// StatusOutput returns the writer for progress and confirmation messages. They
+// go to stderr so that stdout only holds the results, whatever the output format.
+func StatusOutput() io.Writer {
+	return os.Stderr
+}
+
 // Colorize the message accoring the colors var
 func Colorize(message string, colors string) string {
 	//If Colors is disable return the message as it is.
@@ -0,0 +1,162 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package formatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yugabyte/ybm-cli/internal/manifest"
+)
+
+const (
+	defaultResourceDiffListing = "table {{.Action}}\t{{.Resource}}\t{{.Field}}\t{{.Live}}\t{{.Desired}}"
+	actionHeader               = "Action"
+	resourceHeader             = "Resource"
+	fieldHeader                = "Field"
+	liveHeader                 = "Live"
+	desiredHeader              = "Desired"
+	driftHeader                = "Drift"
+	baselineHeader             = "Baseline"
+
+	// MarkdownFormatKey is the output format of drift reports meant for
+	// issues and pull requests
+	MarkdownFormatKey = "markdown"
+)
+
+type ResourceDiffContext struct {
+	HeaderContext
+	Context
+	d manifest.ResourceDiff
+	c manifest.Change
+}
+
+func NewResourceDiffFormat(source string) Format {
+	switch source {
+	case "table", "":
+		format := defaultResourceDiffListing
+		return Format(format)
+	default: // custom format or json or pretty
+		return Format(source)
+	}
+}
+
+// ResourceDiffWrite renders the changes of resources, a row per changed field
+// in a table and a record per resource otherwise
+func ResourceDiffWrite(ctx Context, diffs []manifest.ResourceDiff) error {
+	return ctx.Write(NewResourceDiffContext(), renderResourceDiffs(ctx, diffs))
+}
+
+// DriftWrite renders the drifts of resources from a baseline like
+// ResourceDiffWrite, or as a markdown table
+func DriftWrite(ctx Context, diffs []manifest.ResourceDiff) error {
+	if ctx.Format == MarkdownFormatKey {
+		return driftMarkdownWrite(ctx.Output, diffs)
+	}
+	return ctx.Write(NewDriftContext(), renderResourceDiffs(ctx, diffs))
+}
+
+func driftMarkdownWrite(output io.Writer, diffs []manifest.ResourceDiff) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ").Replace
+	var b strings.Builder
+	fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", driftHeader, resourceHeader, fieldHeader, liveHeader, baselineHeader)
+	b.WriteString("|---|---|---|---|---|\n")
+	for _, diff := range diffs {
+		changes := diff.Changes
+		if len(changes) == 0 {
+			changes = []manifest.Change{{}}
+		}
+		for _, change := range changes {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", diff.Action, escape(fmt.Sprintf("%s %s", diff.Kind, diff.Name)),
+				escape(change.Path), escape(change.Live), escape(change.Desired))
+		}
+	}
+	_, err := io.WriteString(output, b.String())
+	return err
+}
+
+func renderResourceDiffs(ctx Context, diffs []manifest.ResourceDiff) SubFormat {
+	return func(format func(subContext SubContext) error) error {
+		for _, diff := range diffs {
+			changes := diff.Changes
+			if !ctx.Format.IsTable() || len(changes) == 0 {
+				changes = []manifest.Change{{}}
+			}
+			for _, change := range changes {
+				err := format(&ResourceDiffContext{d: diff, c: change})
+				if err != nil {
+					logrus.Debugf("Error rendering resource diff: %v", err)
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// NewResourceDiffContext creates a new context for rendering resource diffs
+func NewResourceDiffContext() *ResourceDiffContext {
+	resourceDiffCtx := ResourceDiffContext{}
+	resourceDiffCtx.Header = SubHeaderContext{
+		"Action":   actionHeader,
+		"Resource": resourceHeader,
+		"Field":    fieldHeader,
+		"Live":     liveHeader,
+		"Desired":  desiredHeader,
+	}
+	return &resourceDiffCtx
+}
+
+// NewDriftContext creates a new context for rendering drifts, the desired
+// values being the ones of the baseline
+func NewDriftContext() *ResourceDiffContext {
+	driftCtx := ResourceDiffContext{}
+	driftCtx.Header = SubHeaderContext{
+		"Action":   driftHeader,
+		"Resource": resourceHeader,
+		"Field":    fieldHeader,
+		"Live":     liveHeader,
+		"Desired":  baselineHeader,
+	}
+	return &driftCtx
+}
+
+func (c *ResourceDiffContext) Action() string {
+	return c.d.Action
+}
+
+func (c *ResourceDiffContext) Resource() string {
+	return fmt.Sprintf("%s %s", c.d.Kind, c.d.Name)
+}
+
+func (c *ResourceDiffContext) Field() string {
+	return c.c.Path
+}
+
+func (c *ResourceDiffContext) Live() string {
+	return c.c.Live
+}
+
+func (c *ResourceDiffContext) Desired() string {
+	return c.c.Desired
+}
+
+func (c *ResourceDiffContext) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.d)
+}
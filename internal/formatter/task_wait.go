@@ -0,0 +1,108 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package formatter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+)
+
+const (
+	defaultTaskWaitListing = "table {{.Name}}\t{{.TaskType}}\t{{.State}}\t{{.Duration}}\t{{.Error}}"
+	errorHeader            = "Error"
+)
+
+type TaskWaitContext struct {
+	HeaderContext
+	Context
+	r ybmAuthClient.TaskWaitResult
+}
+
+func NewTaskWaitFormat(source string) Format {
+	switch source {
+	case "table", "":
+		format := defaultTaskWaitListing
+		return Format(format)
+	default: // custom format or json or pretty
+		return Format(source)
+	}
+}
+
+// TaskWaitWrite renders the summary of the tasks waited for together
+func TaskWaitWrite(ctx Context, results []ybmAuthClient.TaskWaitResult) error {
+	render := func(format func(subContext SubContext) error) error {
+		for _, result := range results {
+			err := format(&TaskWaitContext{r: result})
+			if err != nil {
+				logrus.Debugf("Error rendering task result: %v", err)
+				return err
+			}
+		}
+		return nil
+	}
+	return ctx.Write(NewTaskWaitContext(), render)
+}
+
+// NewTaskWaitContext creates a new context for rendering task results
+func NewTaskWaitContext() *TaskWaitContext {
+	taskWaitCtx := TaskWaitContext{}
+	taskWaitCtx.Header = SubHeaderContext{
+		"Name":     nameHeader,
+		"TaskType": taskTypeHeader,
+		"State":    stateHeader,
+		"Duration": durationHeader,
+		"Error":    errorHeader,
+	}
+	return &taskWaitCtx
+}
+
+func (c *TaskWaitContext) Name() string {
+	return c.r.Name
+}
+
+func (c *TaskWaitContext) TaskType() string {
+	return string(c.r.TaskType)
+}
+
+func (c *TaskWaitContext) State() string {
+	if c.r.Succeeded() {
+		return c.r.State
+	}
+	return Colorize(c.r.State, RED_COLOR)
+}
+
+func (c *TaskWaitContext) Duration() string {
+	return c.r.Duration.Round(time.Second).String()
+}
+
+func (c *TaskWaitContext) Error() string {
+	return c.r.Error
+}
+
+func (c *TaskWaitContext) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"name":             c.r.Name,
+		"entity_id":        c.r.EntityId,
+		"entity_type":      c.r.EntityType,
+		"task_type":        c.r.TaskType,
+		"state":            c.r.State,
+		"error":            c.r.Error,
+		"duration_seconds": c.r.Duration.Seconds(),
+	})
+}
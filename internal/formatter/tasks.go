@@ -0,0 +1,247 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+const (
+	defaultTaskListing       = "table {{.Id}}\t{{.TaskType}}\t{{.EntityType}}\t{{.EntityId}}\t{{.State}}\t{{.CreatedOn}}\t{{.Progress}}"
+	defaultFullTaskListing   = "table {{.Id}}\t{{.TaskType}}\t{{.EntityType}}\t{{.EntityId}}\t{{.State}}\t{{.CreatedOn}}\t{{.CompletedOn}}"
+	defaultTaskActionListing = "table {{.Name}}\t{{.PercentComplete}}"
+	taskIdHeader             = "ID"
+	taskTypeHeader           = "Type"
+	entityTypeHeader         = "Entity Type"
+	entityIdHeader           = "Entity ID"
+	completedOnHeader        = "Completed On"
+	progressHeader           = "Progress"
+	percentCompleteHeader    = "Completed"
+)
+
+type TaskContext struct {
+	HeaderContext
+	Context
+	t ybmclient.TaskData
+}
+
+func NewTaskFormat(source string) Format {
+	switch source {
+	case "table", "":
+		format := defaultTaskListing
+		return Format(format)
+	default: // custom format or json or pretty
+		return Format(source)
+	}
+}
+
+// TaskWrite renders the context for a list of tasks
+func TaskWrite(ctx Context, tasks []ybmclient.TaskData) error {
+	render := func(format func(subContext SubContext) error) error {
+		for _, task := range tasks {
+			err := format(&TaskContext{t: task})
+			if err != nil {
+				logrus.Debugf("Error rendering task: %v", err)
+				return err
+			}
+		}
+		return nil
+	}
+	return ctx.Write(NewTaskContext(), render)
+}
+
+// NewTaskContext creates a new context for rendering tasks
+func NewTaskContext() *TaskContext {
+	taskCtx := TaskContext{}
+	taskCtx.Header = SubHeaderContext{
+		"Id":          taskIdHeader,
+		"TaskType":    taskTypeHeader,
+		"EntityType":  entityTypeHeader,
+		"EntityId":    entityIdHeader,
+		"State":       stateHeader,
+		"CreatedOn":   backupIdCreateOnHeader,
+		"CompletedOn": completedOnHeader,
+		"Progress":    progressHeader,
+	}
+	return &taskCtx
+}
+
+func (c *TaskContext) Id() string {
+	return c.t.Info.GetId()
+}
+
+func (c *TaskContext) TaskType() string {
+	return string(c.t.Info.GetTaskType())
+}
+
+func (c *TaskContext) EntityType() string {
+	return string(c.t.Info.GetEntityType())
+}
+
+func (c *TaskContext) EntityId() string {
+	return c.t.Info.GetEntityId()
+}
+
+func (c *TaskContext) State() string {
+	return c.t.Info.GetState()
+}
+
+func (c *TaskContext) CreatedOn() string {
+	if c.t.Info.GetCreatedOn() == "" {
+		return ""
+	}
+	return FormatDate(c.t.Info.GetCreatedOn())
+}
+
+func (c *TaskContext) CompletedOn() string {
+	if c.t.Info.GetCompletedOn() == "" {
+		return ""
+	}
+	return FormatDate(c.t.Info.GetCompletedOn())
+}
+
+func (c *TaskContext) Progress() string {
+	if percent, ok := ybm.TaskPercentComplete(c.t); ok {
+		return fmt.Sprintf("%d%%", percent)
+	}
+	return "-"
+}
+
+func (c *TaskContext) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.t)
+}
+
+type FullTaskContext struct {
+	HeaderContext
+	Context
+	task ybmclient.TaskData
+}
+
+// NewFullTaskContext creates a new context for rendering all task details
+func NewFullTaskContext() *FullTaskContext {
+	taskCtx := FullTaskContext{}
+	taskCtx.Header = NewTaskContext().Header
+	return &taskCtx
+}
+
+func NewFullTaskFormat(source string) Format {
+	switch source {
+	case "table", "":
+		format := defaultFullTaskListing
+		return Format(format)
+	default: // custom format or json or pretty
+		return Format(source)
+	}
+}
+
+func (t *FullTaskContext) SetFullTask(task ybmclient.TaskData) {
+	t.task = task
+}
+
+func (t *FullTaskContext) startSubsection(format string) (*template.Template, error) {
+	t.buffer = bytes.NewBufferString("")
+	t.header = ""
+	t.Format = Format(format)
+	t.preFormat()
+
+	return t.parseFormat()
+}
+
+func (t *FullTaskContext) SubSection(name string) {
+	t.Output.Write([]byte("\n\n"))
+	t.Output.Write([]byte(Colorize(name, GREEN_COLOR)))
+	t.Output.Write([]byte("\n"))
+}
+
+// taskActionContext renders one action of the progress info of a task
+type taskActionContext struct {
+	HeaderContext
+	name            string
+	percentComplete int
+	raw             interface{}
+}
+
+func NewTaskActionContext() *taskActionContext {
+	actionCtx := taskActionContext{}
+	actionCtx.Header = SubHeaderContext{
+		"Name":            nameHeader,
+		"PercentComplete": percentCompleteHeader,
+	}
+	return &actionCtx
+}
+
+func (a *taskActionContext) Name() string {
+	return a.name
+}
+
+func (a *taskActionContext) PercentComplete() string {
+	return fmt.Sprintf("%d%%", a.percentComplete)
+}
+
+func (a *taskActionContext) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.raw)
+}
+
+func (t *FullTaskContext) Write() error {
+	tmpl, err := t.startSubsection(defaultFullTaskListing)
+	if err != nil {
+		return err
+	}
+	t.Output.Write([]byte(Colorize("General", GREEN_COLOR)))
+	t.Output.Write([]byte("\n"))
+	if err := t.contextFormat(tmpl, &TaskContext{t: t.task}); err != nil {
+		return err
+	}
+	t.postFormat(tmpl, NewTaskContext())
+
+	progress, ok := t.task.Info.GetTaskProgressInfoOk()
+	if !ok || progress == nil || len(progress.GetActions()) == 0 {
+		return nil
+	}
+	tmpl, err = t.startSubsection(defaultTaskActionListing)
+	if err != nil {
+		return err
+	}
+	t.SubSection("Actions")
+	for _, action := range progress.GetActions() {
+		actionCtx := &taskActionContext{name: action.GetName(), percentComplete: int(action.GetPercentComplete()), raw: action}
+		if err := t.contextFormat(tmpl, actionCtx); err != nil {
+			return err
+		}
+	}
+	t.postFormat(tmpl, NewTaskActionContext())
+	return nil
+}
+
+// SingleTaskWrite renders the context for a single task
+func SingleTaskWrite(ctx Context, task ybmclient.TaskData) error {
+	render := func(format func(subContext SubContext) error) error {
+		err := format(&TaskContext{t: task})
+		if err != nil {
+			logrus.Debugf("Error rendering task: %v", err)
+			return err
+		}
+		return nil
+	}
+	return ctx.Write(NewTaskContext(), render)
+}
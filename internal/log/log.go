@@ -17,31 +17,113 @@ package log
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	easy "github.com/t-tomalak/logrus-easy-formatter"
+	"github.com/yugabyte/ybm-cli/internal/redact"
 )
 
+// RedactFormatter masks secrets in the message and fields before
+// delegating to the wrapped formatter, so debug logs are safe to share.
+type RedactFormatter struct {
+	logrus.Formatter
+}
+
+func (f *RedactFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	entry.Message = redact.String(entry.Message)
+	for key, value := range entry.Data {
+		switch v := value.(type) {
+		case string:
+			entry.Data[key] = redact.String(v)
+		case error:
+			entry.Data[key] = redact.String(v.Error())
+		case fmt.Stringer:
+			entry.Data[key] = redact.String(v.String())
+		}
+	}
+	return f.Formatter.Format(entry)
+}
+
+// jsonFormatter emits one JSON record per entry with timestamp and level,
+// dropping the trailing newlines most of our messages carry.
+type jsonFormatter struct {
+	logrus.JSONFormatter
+}
+
+func (f *jsonFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	entry.Message = strings.TrimRight(entry.Message, "\n")
+	return f.JSONFormatter.Format(entry)
+}
+
+func newJSONFormatter() *jsonFormatter {
+	return &jsonFormatter{logrus.JSONFormatter{
+		TimestampFormat:  time.RFC3339Nano,
+		CallerPrettyfier: callerPrettyfier,
+	}}
+}
+
+func callerPrettyfier(f *runtime.Frame) (string, string) {
+	//We don't need the full path to just returning the file
+	return "", fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
+}
+
+func isJSONFormat() bool {
+	return strings.EqualFold(viper.GetString("log-format"), "json")
+}
+
+// ValidateLogFormat checks the value given to --log-format
+func ValidateLogFormat(format string) error {
+	switch strings.ToLower(format) {
+	case "", "text", "json":
+		return nil
+	}
+	return fmt.Errorf("log format must be either 'text' or 'json', got '%s'", format)
+}
+
 func SetFormatter() {
-	logrus.SetFormatter(&easy.Formatter{
+	if isJSONFormat() {
+		logrus.SetFormatter(&RedactFormatter{newJSONFormatter()})
+		return
+	}
+	logrus.SetFormatter(&RedactFormatter{&easy.Formatter{
 		LogFormat: "%msg%",
-	})
+	}})
 }
 
 func SetDebugFormatter() {
 	logrus.SetReportCaller(true)
-	logrus.SetFormatter(&logrus.TextFormatter{
+	if isJSONFormat() {
+		logrus.SetFormatter(&RedactFormatter{newJSONFormatter()})
+		return
+	}
+	logrus.SetFormatter(&RedactFormatter{&logrus.TextFormatter{
 		DisableColors:          viper.GetBool("no-color"),
 		DisableLevelTruncation: true,
-		CallerPrettyfier: func(f *runtime.Frame) (string, string) {
-			//We don't need the full path to just returning the file
-			return "", fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
-		},
-	})
+		CallerPrettyfier:       callerPrettyfier,
+	}})
+}
+
+// SetOutput sends diagnostics to stderr, and additionally to a size rotated
+// log file when logFile is set. Results are the only thing written to stdout.
+func SetOutput(logFile string, maxSizeMB int, maxBackups int) error {
+	if strings.TrimSpace(logFile) == "" {
+		logrus.SetOutput(os.Stderr)
+		return nil
+	}
+	file, err := NewRotatingFile(logFile, int64(maxSizeMB)*1024*1024, maxBackups)
+	if err != nil {
+		logrus.SetOutput(os.Stderr)
+		return err
+	}
+	logrus.SetOutput(io.MultiWriter(os.Stderr, file))
+	return nil
 }
 
 func SetLogLevel(logLevel string, debug bool) {
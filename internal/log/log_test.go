@@ -0,0 +1,80 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/log"
+)
+
+var _ = Describe("Log", func() {
+	Context("When rotating the log file", func() {
+		It("should keep the configured number of backups", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "ybm.log")
+			file, err := log.NewRotatingFile(path, 10, 2)
+			Expect(err).ToNot(HaveOccurred())
+			for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+				_, err := file.Write([]byte(line))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(file.Close()).To(Succeed())
+
+			Expect(os.ReadFile(path)).To(Equal([]byte("fourth\n")))
+			Expect(os.ReadFile(path + ".1")).To(Equal([]byte("third\n")))
+			Expect(os.ReadFile(path + ".2")).To(Equal([]byte("second\n")))
+			Expect(path + ".3").ToNot(BeAnExistingFile())
+		})
+		It("should reject a non positive size", func() {
+			_, err := log.NewRotatingFile(filepath.Join(GinkgoT().TempDir(), "ybm.log"), 0, 1)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When using the json log format", func() {
+		AfterEach(func() {
+			viper.Set("log-format", "")
+			logrus.SetOutput(os.Stderr)
+		})
+		It("should emit redacted records with level and timestamp", func() {
+			viper.Set("log-format", "json")
+			buffer := &bytes.Buffer{}
+			log.SetFormatter()
+			logrus.SetOutput(buffer)
+			logrus.Infof("payload {\"password\":\"s3cr3t\"}\n")
+
+			var record map[string]string
+			Expect(json.Unmarshal(buffer.Bytes(), &record)).To(Succeed())
+			Expect(record["level"]).To(Equal("info"))
+			Expect(record["time"]).ToNot(BeEmpty())
+			Expect(record["msg"]).ToNot(ContainSubstring("s3cr3t"))
+			Expect(strings.HasSuffix(record["msg"], "\n")).To(BeFalse())
+		})
+		It("should validate the format", func() {
+			Expect(log.ValidateLogFormat("json")).To(Succeed())
+			Expect(log.ValidateLogFormat("TEXT")).To(Succeed())
+			Expect(log.ValidateLogFormat("xml")).ToNot(Succeed())
+		})
+	})
+})
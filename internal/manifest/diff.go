@@ -0,0 +1,179 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yugabyte/ybm-cli/internal/redact"
+)
+
+// Change is a field whose live value differs from the desired one. Live is
+// empty for a field the live resource does not have, Desired for a field only
+// the live resource has.
+type Change struct {
+	Path    string `json:"path"`
+	Live    string `json:"live"`
+	Desired string `json:"desired"`
+}
+
+// Fields flattens spec into paths such as regions[us-west-2].num-nodes. The
+// items of a list of objects are keyed by their region or name, a list of
+// values is sorted and joined with commas.
+func Fields(spec Spec) map[string]string {
+	fields := map[string]string{}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fields
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if decoder.Decode(&value) == nil {
+		flatten("", value, fields)
+	}
+	return fields
+}
+
+func flatten(path string, value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if path != "" {
+				key = path + "." + key
+			}
+			flatten(key, item, fields)
+		}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for i, item := range v {
+			if object, ok := item.(map[string]interface{}); ok {
+				flatten(fmt.Sprintf("%s[%s]", path, itemKey(object, i)), object, fields)
+				continue
+			}
+			values = append(values, fmt.Sprint(item))
+		}
+		if len(values) > 0 || len(v) == 0 {
+			sort.Strings(values)
+			fields[path] = strings.Join(values, ",")
+		}
+	case nil:
+	default:
+		fields[path] = fmt.Sprint(v)
+	}
+}
+
+// itemKey identifies an item of a list of objects by its region or name, or
+// its position otherwise
+func itemKey(item map[string]interface{}, index int) string {
+	for _, key := range []string{"region", "name"} {
+		if value, ok := item[key]; ok {
+			return fmt.Sprint(value)
+		}
+	}
+	return fmt.Sprint(index)
+}
+
+// ignored tells whether path is a secret never read back from the API
+func ignored(spec Spec, path string) bool {
+	if path == "credentials" || strings.HasPrefix(path, "credentials.") {
+		return true
+	}
+	if _, ok := spec.(*ResourceSpec); ok {
+		return redact.IsSecretKey(path[strings.LastIndex(path, ".")+1:])
+	}
+	if integration, ok := spec.(*IntegrationSpec); ok {
+		for _, secret := range IntegrationSecrets[integration.Type] {
+			if path == "config."+secret {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Diff returns the changes needed to bring live to desired. Fields the desired
+// spec leaves out are not compared, except for the items of the lists it sets,
+// such as the regions of a cluster, which are removed.
+func Diff(desired Spec, live Spec) []Change {
+	desiredFields := Fields(desired)
+	liveFields := Fields(live)
+	var changes []Change
+	for path, want := range desiredFields {
+		if ignored(desired, path) {
+			continue
+		}
+		if got := liveFields[path]; got != want {
+			changes = append(changes, Change{Path: path, Live: got, Desired: want})
+		}
+	}
+	for path, got := range liveFields {
+		if _, ok := desiredFields[path]; ok || ignored(live, path) {
+			continue
+		}
+		if item := listItem(path); item != "" && hasList(desiredFields, item) && !hasItem(desiredFields, item) {
+			changes = append(changes, Change{Path: path, Live: got})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// listItem returns the list item of path, such as regions[us-west-2], or an
+// empty string when path is not in a list of objects
+func listItem(path string) string {
+	if i := strings.LastIndex(path, "]"); i >= 0 {
+		return path[:i+1]
+	}
+	return ""
+}
+
+// hasList tells whether the fields set the list of the list item, an empty
+// list included
+func hasList(fields map[string]string, item string) bool {
+	list := item[:strings.LastIndex(item, "[")]
+	for path := range fields {
+		if path == list || strings.HasPrefix(path, list+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasItem tells whether a path of fields is under the list item
+func hasItem(fields map[string]string, item string) bool {
+	for path := range fields {
+		if strings.HasPrefix(path, item+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Paths returns the paths of changes, for messages
+func Paths(changes []Change) []string {
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	return paths
+}
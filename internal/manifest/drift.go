@@ -0,0 +1,65 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+// The drifts of a live resource from its baseline
+const (
+	DriftAdded    = "added"
+	DriftRemoved  = "removed"
+	DriftModified = "modified"
+)
+
+// Drift compares the live resources with a baseline and returns the ones
+// added, removed or modified since, in apply order. Only the fields the
+// baseline sets are compared, and only the kinds it has resources of are
+// checked for added resources, so that a hand-maintained baseline can cover
+// part of the account.
+func Drift(baseline []Manifest, live []Manifest) []ResourceDiff {
+	liveByKey := map[string]Manifest{}
+	for _, m := range live {
+		liveByKey[m.String()] = m
+	}
+	baselineKinds := map[Kind]bool{}
+	baselineKeys := map[string]bool{}
+	var drifted []Manifest
+	diffs := map[string]ResourceDiff{}
+	for _, m := range baseline {
+		baselineKinds[m.Kind] = true
+		baselineKeys[m.String()] = true
+		liveManifest, ok := liveByKey[m.String()]
+		if !ok {
+			drifted = append(drifted, m)
+			diffs[m.String()] = ResourceDiff{Kind: m.Kind, Name: m.Spec.Key(), Action: DriftRemoved}
+			continue
+		}
+		if changes := Diff(m.Spec, liveManifest.Spec); len(changes) > 0 {
+			drifted = append(drifted, m)
+			diffs[m.String()] = ResourceDiff{Kind: m.Kind, Name: m.Spec.Key(), Action: DriftModified, Changes: changes}
+		}
+	}
+	for _, m := range live {
+		if baselineKinds[m.Kind] && !baselineKeys[m.String()] {
+			drifted = append(drifted, m)
+			diffs[m.String()] = ResourceDiff{Kind: m.Kind, Name: m.Spec.Key(), Action: DriftAdded}
+		}
+	}
+	Sort(drifted)
+	result := make([]ResourceDiff, 0, len(drifted))
+	for _, m := range drifted {
+		result = append(result, diffs[m.String()])
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+)
+
+// ExportError tells that the resources of a kind could not be read
+type ExportError struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *ExportError) Error() string {
+	return fmt.Sprintf("could not export the %s resources: %s", e.Kind, ybmAuthClient.GetApiErrorDetails(e.Err))
+}
+
+// Export reads every resource of the account as manifests, in apply order.
+// The kinds that cannot be read, such as the ones of a disabled feature, are
+// skipped and reported in the returned errors.
+func (r *Reader) Export() ([]Manifest, []error) {
+	var manifests []Manifest
+	var errs []error
+	// add collects the specs of a kind, or the error reading them
+	add := func(kind Kind) func([]Spec, error) {
+		return func(specs []Spec, err error) {
+			if err != nil {
+				errs = append(errs, &ExportError{Kind: kind, Err: err})
+				return
+			}
+			for _, spec := range specs {
+				manifests = append(manifests, Manifest{Kind: kind, Spec: spec})
+			}
+		}
+	}
+
+	add(KindNetworkAllowList)(r.networkAllowLists())
+	add(KindVpc)(r.vpcSpecs())
+	add(KindVpcPeering)(r.vpcPeerings())
+	add(KindIntegration)(r.integrations())
+	add(KindRole)(specsOf(r.Roles()))
+
+	clusters, _, err := r.authApi.ListClustersPaged(r.authApi.ListClusters(), ybmAuthClient.AllPages)
+	if err != nil {
+		add(KindCluster)(nil, err)
+	}
+	for _, cluster := range clusters {
+		r.clusters[cluster.Spec.Name] = cluster
+		r.clusterNames[cluster.Info.GetId()] = cluster.Spec.Name
+		spec, err := r.ClusterSpec(cluster)
+		if err == nil {
+			// The credentials are only needed to create the cluster again
+			spec.Credentials = &Credentials{
+				Username: Placeholder(spec.Name, "username"),
+				Password: Placeholder(spec.Name, "password"),
+			}
+		}
+		add(KindCluster)([]Spec{spec}, err)
+		for _, kind := range []Kind{KindClusterEncryption, KindDbQueryLogging, KindDbAuditLogging, KindMetricsExporter, KindClusterEndpoints} {
+			add(kind)(specsOf(optional(r.ClusterSettings(kind, cluster))))
+		}
+		add(KindBackupPolicy)(specsOf(optional(r.liveBackupPolicy(cluster.Spec.Name))))
+		add(KindPitrConfig)(r.pitrConfigs(cluster.Spec.Name, cluster.Info.GetId()))
+	}
+
+	add(KindDrConfig)(specsOf(r.DrConfigs()))
+	add(KindCdcSink)(specsOf(r.CdcSinks()))
+	add(KindCdcStream)(specsOf(r.CdcStreams()))
+	Sort(manifests)
+	return manifests, errs
+}
+
+func (r *Reader) networkAllowLists() ([]Spec, error) {
+	resp, _, err := r.authApi.ListNetworkAllowLists().Execute()
+	if err != nil {
+		return nil, err
+	}
+	specs := []Spec{}
+	for _, allowList := range resp.GetData() {
+		specs = append(specs, NetworkAllowListSpecOf(allowList))
+	}
+	return specs, nil
+}
+
+func (r *Reader) vpcSpecs() ([]Spec, error) {
+	resp, _, err := r.authApi.ListSingleTenantVpcs().Execute()
+	if err != nil {
+		return nil, err
+	}
+	specs := []Spec{}
+	for _, vpc := range resp.GetData() {
+		r.vpcs[vpc.Info.GetId()] = vpc.Spec.Name
+		specs = append(specs, VpcSpecOf(vpc))
+	}
+	return specs, nil
+}
+
+func (r *Reader) vpcPeerings() ([]Spec, error) {
+	resp, _, err := r.authApi.ListVpcPeerings().Execute()
+	if err != nil {
+		return nil, err
+	}
+	specs := []Spec{}
+	for _, peering := range resp.GetData() {
+		specs = append(specs, VpcPeeringSpecOf(peering))
+	}
+	return specs, nil
+}
+
+// integrations returns the integrations with placeholders for their secrets,
+// so that the export can be applied once the variables are set
+func (r *Reader) integrations() ([]Spec, error) {
+	resp, _, err := r.authApi.ListIntegrations().Execute()
+	if err != nil {
+		return nil, err
+	}
+	specs := []Spec{}
+	for _, integration := range resp.GetData() {
+		specs = append(specs, WithSecretPlaceholders(IntegrationSpecOf(integration)))
+	}
+	return specs, nil
+}
+
+func (r *Reader) pitrConfigs(clusterName string, clusterID string) ([]Spec, error) {
+	resp, _, err := r.authApi.ListClusterPitrConfigs(clusterID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	specs := []Spec{}
+	for _, pitrConfig := range resp.GetData() {
+		specs = append(specs, PitrConfigSpecOf(clusterName, pitrConfig))
+	}
+	return specs, nil
+}
+
+// optional returns the state as a list, empty when the resource does not exist
+func optional(state *State, err error) ([]State, error) {
+	if state == nil {
+		return nil, err
+	}
+	return []State{*state}, err
+}
+
+// specsOf returns the specs of states
+func specsOf(states []State, err error) ([]Spec, error) {
+	specs := make([]Spec, 0, len(states))
+	for _, state := range states {
+		specs = append(specs, state.Spec)
+	}
+	return specs, err
+}
@@ -0,0 +1,601 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// State is the live state of a resource
+type State struct {
+	Spec Spec
+	// ID of the resource, and of its cluster for the kinds attached to one
+	ID        string
+	ClusterID string
+	// Data is the API object the spec was read from
+	Data interface{}
+}
+
+// Reader reads the live state of the resources described by manifests
+type Reader struct {
+	authApi  *ybmAuthClient.AuthApiClient
+	clusters map[string]ybmclient.ClusterData
+	tracks   map[string]string
+	vpcs     map[string]string
+	// clusterNames caches the names of clusters by ID
+	clusterNames map[string]string
+}
+
+func NewReader(authApi *ybmAuthClient.AuthApiClient) *Reader {
+	return &Reader{
+		authApi:  authApi,
+		clusters: map[string]ybmclient.ClusterData{},
+		tracks:   map[string]string{},
+		vpcs:     map[string]string{},
+
+		clusterNames: map[string]string{},
+	}
+}
+
+// Live returns the live state of the resource described by m, nil when it
+// does not exist
+func (r *Reader) Live(m Manifest) (*State, error) {
+	switch spec := m.Spec.(type) {
+	case *ClusterSpec:
+		return r.liveCluster(spec.Name)
+	case *NetworkAllowListSpec:
+		return r.liveNetworkAllowList(spec.Name)
+	case *VpcSpec:
+		return r.liveVpc(spec.Name)
+	case *VpcPeeringSpec:
+		return r.liveVpcPeering(spec.Name)
+	case *IntegrationSpec:
+		return r.liveIntegration(spec.Name)
+	case *BackupPolicySpec:
+		return r.liveBackupPolicy(spec.Cluster)
+	case *PitrConfigSpec:
+		return r.livePitrConfig(spec)
+	case *ResourceSpec:
+		return r.liveResource(m.Kind, spec)
+	}
+	return nil, fmt.Errorf("unsupported kind %s", m.Kind)
+}
+
+// Cluster returns the cluster with the exact name, nil when there is none
+func (r *Reader) Cluster(name string) (*ybmclient.ClusterData, error) {
+	if cluster, ok := r.clusters[name]; ok {
+		return &cluster, nil
+	}
+	resp, _, err := r.authApi.ListClusters().Name(name).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range resp.GetData() {
+		if cluster.Spec.Name == name {
+			r.clusters[name] = cluster
+			return &cluster, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reader) liveCluster(name string) (*State, error) {
+	cluster, err := r.Cluster(name)
+	if err != nil || cluster == nil {
+		return nil, err
+	}
+	spec, err := r.ClusterSpec(*cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &State{Spec: spec, ID: cluster.Info.GetId(), ClusterID: cluster.Info.GetId(), Data: *cluster}, nil
+}
+
+// ClusterSpec returns the manifest spec of a live cluster, with the names of
+// its track, VPCs and network allow lists
+func (r *Reader) ClusterSpec(cluster ybmclient.ClusterData) (*ClusterSpec, error) {
+	spec, err := r.FromClusterSpec(cluster.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	allowListResp, _, err := r.authApi.ListClusterNetworkAllowLists(cluster.Info.GetId()).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, allowList := range allowListResp.GetData() {
+		spec.NetworkAllowLists = append(spec.NetworkAllowLists, allowList.Spec.Name)
+	}
+	return spec, nil
+}
+
+// FromClusterSpec returns the manifest spec of an API cluster spec, with the
+// names of its track and VPCs
+func (r *Reader) FromClusterSpec(clusterSpec ybmclient.ClusterSpec) (*ClusterSpec, error) {
+	clusterInfo := clusterSpec.ClusterInfo
+	spec := &ClusterSpec{
+		Name:           clusterSpec.Name,
+		CloudProvider:  string(clusterSpec.CloudInfo.GetCode()),
+		ClusterTier:    "Sandbox",
+		ClusterType:    string(clusterInfo.GetClusterType()),
+		FaultTolerance: string(clusterInfo.GetFaultTolerance()),
+		Regions:        []RegionSpec{},
+	}
+	if clusterInfo.GetClusterTier() == "PAID" {
+		spec.ClusterTier = "Dedicated"
+	}
+	if numFaultsToTolerate, ok := clusterInfo.GetNumFaultsToTolerateOk(); ok && numFaultsToTolerate != nil {
+		value := *numFaultsToTolerate
+		spec.NumFaultsToTolerate = &value
+	}
+	if trackID := clusterSpec.SoftwareInfo.GetTrackId(); trackID != "" {
+		trackName, err := r.trackName(trackID)
+		if err != nil {
+			return nil, err
+		}
+		spec.DatabaseVersion = trackName
+	}
+	for _, clusterRegionInfo := range clusterSpec.ClusterRegionInfo {
+		region := RegionSpec{
+			Region:   clusterRegionInfo.PlacementInfo.CloudInfo.GetRegion(),
+			NumNodes: clusterRegionInfo.PlacementInfo.GetNumNodes(),
+		}
+		if vpcID, ok := clusterRegionInfo.PlacementInfo.GetVpcIdOk(); ok && vpcID != nil && *vpcID != "" {
+			vpcName, err := r.vpcName(*vpcID)
+			if err != nil {
+				return nil, err
+			}
+			region.Vpc = vpcName
+		}
+		if target, ok := clusterRegionInfo.GetBackupReplicationGcpTargetOk(); ok && target != nil {
+			region.BackupReplicationGcpTarget = *target
+		}
+		if nodeInfo, ok := clusterRegionInfo.GetNodeInfoOk(); ok && nodeInfo != nil {
+			region.NumCores = nodeInfo.GetNumCores()
+			region.DiskSizeGb = nodeInfo.GetDiskSizeGb()
+			if diskIops, ok := nodeInfo.GetDiskIopsOk(); ok && diskIops != nil {
+				region.DiskIops = *diskIops
+			}
+		}
+		spec.Regions = append(spec.Regions, region)
+	}
+	return spec, nil
+}
+
+func (r *Reader) trackName(trackID string) (string, error) {
+	if name, ok := r.tracks[trackID]; ok {
+		return name, nil
+	}
+	name, err := r.authApi.GetTrackNameById(trackID)
+	if err != nil {
+		return "", err
+	}
+	r.tracks[trackID] = name
+	return name, nil
+}
+
+func (r *Reader) vpcName(vpcID string) (string, error) {
+	if name, ok := r.vpcs[vpcID]; ok {
+		return name, nil
+	}
+	name, err := r.authApi.GetVpcNameById(vpcID)
+	if err != nil {
+		return "", err
+	}
+	r.vpcs[vpcID] = name
+	return name, nil
+}
+
+func (r *Reader) liveNetworkAllowList(name string) (*State, error) {
+	resp, _, err := r.authApi.ListNetworkAllowLists().Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, allowList := range resp.GetData() {
+		if allowList.Spec.Name == name {
+			return &State{Spec: NetworkAllowListSpecOf(allowList), ID: allowList.Info.GetId(), Data: allowList}, nil
+		}
+	}
+	return nil, nil
+}
+
+// NetworkAllowListSpecOf returns the manifest spec of a live network allow list
+func NetworkAllowListSpecOf(allowList ybmclient.NetworkAllowListData) *NetworkAllowListSpec {
+	return &NetworkAllowListSpec{
+		Name:        allowList.Spec.Name,
+		Description: allowList.Spec.Description,
+		IpAddresses: allowList.Spec.AllowList,
+	}
+}
+
+func (r *Reader) liveVpc(name string) (*State, error) {
+	resp, _, err := r.authApi.ListSingleTenantVpcsByName(name).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, vpc := range resp.GetData() {
+		if vpc.Spec.Name == name {
+			return &State{Spec: VpcSpecOf(vpc), ID: vpc.Info.GetId(), Data: vpc}, nil
+		}
+	}
+	return nil, nil
+}
+
+// VpcSpecOf returns the manifest spec of a live VPC. The regions of a VPC with
+// a global CIDR are left out since they are derived from it.
+func VpcSpecOf(vpc ybmclient.SingleTenantVpcDataResponse) *VpcSpec {
+	spec := &VpcSpec{
+		Name:          vpc.Spec.Name,
+		CloudProvider: string(vpc.Spec.GetCloud()),
+		GlobalCidr:    vpc.Spec.GetParentCidr(),
+	}
+	if spec.GlobalCidr == "" {
+		for _, regionSpec := range vpc.Spec.RegionSpecs {
+			spec.Regions = append(spec.Regions, VpcRegionSpec{Region: regionSpec.GetRegion(), Cidr: regionSpec.GetCidr()})
+		}
+	}
+	return spec
+}
+
+func (r *Reader) liveVpcPeering(name string) (*State, error) {
+	resp, _, err := r.authApi.ListVpcPeerings().Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, peering := range resp.GetData() {
+		if peering.Spec.Name == name {
+			return &State{Spec: VpcPeeringSpecOf(peering), ID: peering.Info.GetId(), Data: peering}, nil
+		}
+	}
+	return nil, nil
+}
+
+// VpcPeeringSpecOf returns the manifest spec of a live VPC peering
+func VpcPeeringSpecOf(peering ybmclient.VpcPeeringData) *VpcPeeringSpec {
+	customerVpc := peering.Spec.CustomerVpc
+	return &VpcPeeringSpec{
+		Name: peering.Spec.Name,
+		Vpc:  peering.Info.GetYugabyteVpcName(),
+		ApplicationVpc: ApplicationVpcSpec{
+			CloudProvider: string(customerVpc.CloudInfo.GetCode()),
+			Project:       customerVpc.GetCloudProviderProject(),
+			Vpc:           customerVpc.GetExternalVpcId(),
+			Region:        customerVpc.CloudInfo.GetRegion(),
+			Cidr:          customerVpc.GetCidr(),
+		},
+	}
+}
+
+func (r *Reader) liveIntegration(name string) (*State, error) {
+	resp, _, err := r.authApi.ListIntegrations().Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, integration := range resp.GetData() {
+		if integration.GetSpec().Name == name {
+			return &State{Spec: IntegrationSpecOf(integration), ID: integration.GetInfo().Id, Data: integration}, nil
+		}
+	}
+	return nil, nil
+}
+
+// IntegrationSpecOf returns the manifest spec of a live integration, without
+// its secrets
+func IntegrationSpecOf(integration ybmclient.TelemetryProviderData) *IntegrationSpec {
+	provider := integration.GetSpec()
+	config := map[string]string{}
+	switch provider.Type {
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_DATADOG:
+		config["site"] = provider.GetDatadogSpec().Site
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_GRAFANA:
+		grafanaSpec := provider.GetGrafanaSpec()
+		config["zone"] = grafanaSpec.Zone
+		config["instance-id"] = grafanaSpec.InstanceId
+		config["org-slug"] = grafanaSpec.OrgSlug
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_PROMETHEUS:
+		config["endpoint"] = provider.GetPrometheusSpec().Endpoint
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_VICTORIAMETRICS:
+		config["endpoint"] = provider.GetVictoriametricsSpec().Endpoint
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_NEWRELIC:
+		config["endpoint"] = provider.GetNewrelicSpec().Endpoint
+	case ybmclient.TELEMETRYPROVIDERTYPEENUM_AWS_S3:
+		s3Spec := provider.GetAwsS3Spec()
+		config["bucket"] = s3Spec.Bucket
+		config["region"] = s3Spec.Region
+		config["path-prefix"] = s3Spec.GetPathPrefix()
+		config["file-prefix"] = s3Spec.GetFilePrefix()
+		config["partition-strategy"] = s3Spec.GetPartitionStrategy()
+	}
+	for key, value := range config {
+		if value == "" {
+			delete(config, key)
+		}
+	}
+	return &IntegrationSpec{Name: provider.Name, Type: string(provider.Type), Config: config}
+}
+
+func (r *Reader) liveBackupPolicy(clusterName string) (*State, error) {
+	cluster, err := r.Cluster(clusterName)
+	if err != nil || cluster == nil {
+		return nil, err
+	}
+	clusterID := cluster.Info.GetId()
+	resp, _, err := r.authApi.ListBackupPoliciesV2(clusterID, false /* fetchOnlyActive */).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetData()) == 0 {
+		return nil, nil
+	}
+	schedule := resp.GetData()[0]
+	info := schedule.GetInfo()
+	return &State{Spec: BackupPolicySpecOf(clusterName, schedule), ID: info.GetId(), ClusterID: clusterID, Data: schedule}, nil
+}
+
+// BackupPolicySpecOf returns the manifest spec of the backup policy of a cluster
+func BackupPolicySpecOf(clusterName string, schedule ybmclient.BackupScheduleDataV2) *BackupPolicySpec {
+	scheduleSpec := schedule.GetSpec()
+	enabled := scheduleSpec.GetState() == ybmclient.SCHEDULESTATEENUM_ACTIVE
+	return &BackupPolicySpec{
+		Cluster:                             clusterName,
+		RetentionPeriodInDays:               int32(scheduleSpec.GetRetentionPeriodInDays()),
+		FullBackupFrequencyInDays:           int32(scheduleSpec.GetTimeIntervalInDays()),
+		CronExpression:                      scheduleSpec.GetCronExpression(),
+		IncrementalBackupFrequencyInMinutes: int32(scheduleSpec.GetIncrementalIntervalInMinutes()),
+		Enabled:                             &enabled,
+	}
+}
+
+func (r *Reader) livePitrConfig(spec *PitrConfigSpec) (*State, error) {
+	cluster, err := r.Cluster(spec.Cluster)
+	if err != nil || cluster == nil {
+		return nil, err
+	}
+	clusterID := cluster.Info.GetId()
+	resp, _, err := r.authApi.ListClusterPitrConfigs(clusterID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, pitrConfig := range resp.GetData() {
+		if pitrConfig.Info.GetDatabaseName() == spec.NamespaceName && string(pitrConfig.Info.GetDatabaseType()) == spec.NamespaceType {
+			return &State{Spec: PitrConfigSpecOf(spec.Cluster, pitrConfig), ID: pitrConfig.Info.GetId(), ClusterID: clusterID, Data: pitrConfig}, nil
+		}
+	}
+	return nil, nil
+}
+
+// PitrConfigSpecOf returns the manifest spec of a PITR config of a cluster
+func PitrConfigSpecOf(clusterName string, pitrConfig ybmclient.DatabasePitrConfigData) *PitrConfigSpec {
+	return &PitrConfigSpec{
+		Cluster:               clusterName,
+		NamespaceName:         pitrConfig.Info.GetDatabaseName(),
+		NamespaceType:         string(pitrConfig.Info.GetDatabaseType()),
+		RetentionPeriodInDays: pitrConfig.Spec.RetentionPeriod,
+	}
+}
+
+func (r *Reader) liveResource(kind Kind, spec *ResourceSpec) (*State, error) {
+	var states []State
+	var err error
+	switch kind {
+	case KindClusterEncryption, KindDbQueryLogging, KindDbAuditLogging, KindMetricsExporter, KindClusterEndpoints:
+		cluster, err := r.Cluster(spec.Cluster)
+		if err != nil || cluster == nil {
+			return nil, err
+		}
+		return r.ClusterSettings(kind, *cluster)
+	case KindRole:
+		states, err = r.Roles()
+	case KindDrConfig:
+		states, err = r.DrConfigs()
+	case KindCdcSink:
+		states, err = r.CdcSinks()
+	case KindCdcStream:
+		states, err = r.CdcStreams()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		if state.Spec.Key() == spec.Key() {
+			return &state, nil
+		}
+	}
+	return nil, nil
+}
+
+// ClusterSettings returns the encryption, DB query logging, DB audit logging or
+// endpoints settings of a cluster, nil when they are not configured
+func (r *Reader) ClusterSettings(kind Kind, cluster ybmclient.ClusterData) (*State, error) {
+	clusterName := cluster.Spec.Name
+	clusterID := cluster.Info.GetId()
+	spec := &ResourceSpec{Cluster: clusterName}
+	var data interface{}
+	switch kind {
+	case KindClusterEncryption:
+		resp, _, err := r.authApi.ListClusterCMKs(clusterID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp.Data == nil {
+			return nil, nil
+		}
+		spec.Settings = settingsOf(resp.Data.GetSpec(), clusterName+"_cmk")
+		data = *resp.Data
+	case KindDbQueryLogging:
+		resp, _, err := r.authApi.GetDbLoggingConfig(clusterID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.GetData()) == 0 {
+			return nil, nil
+		}
+		config := resp.GetData()[0]
+		spec.Settings = settingsOf(config.Spec, clusterName+"_query_logging", "exporter_id")
+		spec.Settings["exporter"], err = r.authApi.GetIntegrationNameFromId(config.Spec.ExporterId)
+		if err != nil {
+			return nil, err
+		}
+		data = config
+	case KindDbAuditLogging:
+		resp, _, err := r.authApi.ListDbAuditExporterConfig(clusterID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.GetData()) == 0 {
+			return nil, nil
+		}
+		config := resp.GetData()[0]
+		spec.Settings = settingsOf(config.Spec, clusterName+"_audit_logging", "exporter_id")
+		spec.Settings["exporter"], err = r.authApi.GetIntegrationNameFromId(config.Spec.ExporterId)
+		if err != nil {
+			return nil, err
+		}
+		data = config
+	case KindMetricsExporter:
+		resp, _, err := r.authApi.ListMetricsExporterConfigs().Execute()
+		if err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(resp.GetData(), func(config ybmclient.MetricsExporterConfigurationData) bool {
+			info := config.GetInfo()
+			return slices.Contains(info.GetClusterIds(), clusterID)
+		})
+		if i < 0 {
+			return nil, nil
+		}
+		config := resp.GetData()[i]
+		spec.Settings = map[string]interface{}{"exporter": config.GetSpec().Name}
+		data = config
+	case KindClusterEndpoints:
+		// The hosts of the endpoints by accessibility. Both lists are always
+		// set, even when empty, so that a new public endpoint shows as a change
+		// against a baseline which had none.
+		if len(cluster.Info.GetClusterEndpoints()) == 0 {
+			return nil, nil
+		}
+		spec.Settings = map[string]interface{}{"private": []interface{}{}, "public": []interface{}{}}
+		for _, endpoint := range cluster.Info.GetClusterEndpoints() {
+			accessibility := strings.ToLower(string(endpoint.GetAccessibilityType()))
+			hosts, _ := spec.Settings[accessibility].([]interface{})
+			spec.Settings[accessibility] = append(hosts, endpoint.GetHost())
+		}
+		data = cluster.Info.GetClusterEndpoints()
+	default:
+		return nil, fmt.Errorf("%s is not a setting of a cluster", kind)
+	}
+	return &State{Spec: spec, ClusterID: clusterID, Data: data}, nil
+}
+
+// Roles returns the custom roles of the account, the built-in roles cannot change
+func (r *Reader) Roles() ([]State, error) {
+	roles, _, err := r.authApi.ListRbacRolesPaged(r.authApi.ListAllRbacRolesWithPermissions(), ybmAuthClient.AllPages)
+	if err != nil {
+		return nil, err
+	}
+	states := []State{}
+	for _, role := range roles {
+		if !role.Info.GetIsUserDefined() {
+			continue
+		}
+		name := role.Info.GetDisplayName()
+		spec := &ResourceSpec{Name: name, Settings: settingsOf(role.Spec, name, "name")}
+		states = append(states, State{Spec: spec, ID: role.Info.GetId(), Data: role})
+	}
+	return states, nil
+}
+
+// DrConfigs returns the disaster recovery configs of the account, attached to
+// their source cluster
+func (r *Reader) DrConfigs() ([]State, error) {
+	resp, _, err := r.authApi.ListXClusterDr().Execute()
+	if err != nil {
+		return nil, err
+	}
+	states := []State{}
+	for _, dr := range resp.GetData() {
+		name := dr.Spec.GetName()
+		sourceCluster, err := r.clusterName(dr.Info.GetSourceClusterId())
+		if err != nil {
+			return nil, err
+		}
+		spec := &ResourceSpec{Name: name, Cluster: sourceCluster, Settings: settingsOf(dr.Spec, name, "name", "target_cluster_id")}
+		if targetClusterID, ok := dr.Info.GetTargetClusterIdOk(); ok && targetClusterID != nil {
+			spec.Settings["target_cluster"], err = r.clusterName(*targetClusterID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		states = append(states, State{Spec: spec, ID: dr.Info.GetId(), ClusterID: dr.Info.GetSourceClusterId(), Data: dr})
+	}
+	return states, nil
+}
+
+// CdcSinks returns the CDC sinks of the account
+func (r *Reader) CdcSinks() ([]State, error) {
+	sinks, _, err := r.authApi.ListCdcSinksPaged(r.authApi.ListCdcSinks(), ybmAuthClient.AllPages)
+	if err != nil {
+		return nil, err
+	}
+	states := []State{}
+	for _, sink := range sinks {
+		name := sink.Spec.GetName()
+		spec := &ResourceSpec{Name: name, Settings: settingsOf(sink.Spec, name, "name")}
+		states = append(states, State{Spec: spec, ID: sink.Info.GetId(), Data: sink})
+	}
+	return states, nil
+}
+
+// CdcStreams returns the CDC streams of the account, with the names of their sinks
+func (r *Reader) CdcStreams() ([]State, error) {
+	sinks, err := r.CdcSinks()
+	if err != nil {
+		return nil, err
+	}
+	sinkNames := map[string]string{}
+	for _, sink := range sinks {
+		sinkNames[sink.ID] = sink.Spec.Key()
+	}
+	streams, _, err := r.authApi.ListCdcStreamsPaged(r.authApi.ListCdcStreamsForAccount(), ybmAuthClient.AllPages)
+	if err != nil {
+		return nil, err
+	}
+	states := []State{}
+	for _, stream := range streams {
+		name := stream.Spec.GetName()
+		spec := &ResourceSpec{Name: name, Settings: settingsOf(stream.Spec, name, "name", "cdc_sink_id")}
+		spec.Settings["sink"] = sinkNames[stream.Spec.CdcSinkId]
+		states = append(states, State{Spec: spec, ID: stream.Info.GetId(), Data: stream})
+	}
+	return states, nil
+}
+
+func (r *Reader) clusterName(clusterID string) (string, error) {
+	if name, ok := r.clusterNames[clusterID]; ok {
+		return name, nil
+	}
+	resp, _, err := r.authApi.GetCluster(clusterID).Execute()
+	if err != nil {
+		return "", err
+	}
+	name := resp.Data.Spec.GetName()
+	r.clusterNames[clusterID] = name
+	return name, nil
+}
@@ -0,0 +1,521 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package manifest reads and compares the YAML or JSON manifests describing
+// the resources of an account, as used by ybm apply
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	"gopkg.in/yaml.v3"
+)
+
+// Kind is the type of resource a manifest describes
+type Kind string
+
+const (
+	KindNetworkAllowList Kind = "NetworkAllowList"
+	KindVpc              Kind = "Vpc"
+	KindVpcPeering       Kind = "VpcPeering"
+	KindIntegration      Kind = "Integration"
+	KindCluster          Kind = "Cluster"
+	KindBackupPolicy     Kind = "BackupPolicy"
+	KindPitrConfig       Kind = "PitrConfig"
+
+	// The kinds below are exported and compared but not applied
+	KindRole              Kind = "Role"
+	KindClusterEncryption Kind = "ClusterEncryption"
+	KindDbQueryLogging    Kind = "DbQueryLogging"
+	KindDbAuditLogging    Kind = "DbAuditLogging"
+	KindMetricsExporter   Kind = "MetricsExporter"
+	KindClusterEndpoints  Kind = "ClusterEndpoints"
+	KindDrConfig          Kind = "DrConfig"
+	KindCdcSink           Kind = "CdcSink"
+	KindCdcStream         Kind = "CdcStream"
+)
+
+// Kinds lists every kind in the order resources are applied, a resource only
+// refers to resources of the kinds before it
+var Kinds = []Kind{
+	KindNetworkAllowList,
+	KindVpc,
+	KindVpcPeering,
+	KindIntegration,
+	KindRole,
+	KindCluster,
+	KindClusterEncryption,
+	KindDbQueryLogging,
+	KindDbAuditLogging,
+	KindMetricsExporter,
+	KindClusterEndpoints,
+	KindBackupPolicy,
+	KindPitrConfig,
+	KindDrConfig,
+	KindCdcSink,
+	KindCdcStream,
+}
+
+// ReadOnly tells whether apply leaves the resources of the kind alone, they
+// are only exported and compared
+func (k Kind) ReadOnly() bool {
+	switch k {
+	case KindRole, KindClusterEncryption, KindDbQueryLogging, KindDbAuditLogging, KindMetricsExporter, KindClusterEndpoints, KindDrConfig, KindCdcSink, KindCdcStream:
+		return true
+	}
+	return false
+}
+
+// Spec is the desired state of a resource
+type Spec interface {
+	// Key identifies the resource among the resources of its kind
+	Key() string
+}
+
+// Manifest is one resource of a manifest file
+type Manifest struct {
+	Kind Kind `json:"kind" yaml:"kind"`
+	Spec Spec `json:"spec" yaml:"spec"`
+	// Source is the file the manifest was read from
+	Source string `json:"-" yaml:"-"`
+}
+
+func (m Manifest) String() string {
+	return fmt.Sprintf("%s %s", m.Kind, m.Spec.Key())
+}
+
+// ClusterSpec describes a cluster, the fields follow the flags of cluster create
+type ClusterSpec struct {
+	Name                string       `json:"name" yaml:"name"`
+	CloudProvider       string       `json:"cloud-provider,omitempty" yaml:"cloud-provider,omitempty"`
+	ClusterTier         string       `json:"cluster-tier,omitempty" yaml:"cluster-tier,omitempty"`
+	ClusterType         string       `json:"cluster-type,omitempty" yaml:"cluster-type,omitempty"`
+	FaultTolerance      string       `json:"fault-tolerance,omitempty" yaml:"fault-tolerance,omitempty"`
+	NumFaultsToTolerate *int32       `json:"num-faults-to-tolerate,omitempty" yaml:"num-faults-to-tolerate,omitempty"`
+	DatabaseVersion     string       `json:"database-version,omitempty" yaml:"database-version,omitempty"`
+	Regions             []RegionSpec `json:"regions" yaml:"regions"`
+	NetworkAllowLists   []string     `json:"network-allow-lists,omitempty" yaml:"network-allow-lists,omitempty"`
+	// Credentials are only used to create the cluster
+	Credentials *Credentials `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+}
+
+// RegionSpec describes the nodes of a cluster in one region
+type RegionSpec struct {
+	Region     string `json:"region" yaml:"region"`
+	NumNodes   int32  `json:"num-nodes,omitempty" yaml:"num-nodes,omitempty"`
+	Vpc        string `json:"vpc,omitempty" yaml:"vpc,omitempty"`
+	NumCores   int32  `json:"num-cores,omitempty" yaml:"num-cores,omitempty"`
+	DiskSizeGb int32  `json:"disk-size-gb,omitempty" yaml:"disk-size-gb,omitempty"`
+	DiskIops   int32  `json:"disk-iops,omitempty" yaml:"disk-iops,omitempty"`
+	// BackupReplicationGcpTarget is the GCP bucket the backups of the region are replicated to
+	BackupReplicationGcpTarget string `json:"backup-replication-gcp-target,omitempty" yaml:"backup-replication-gcp-target,omitempty"`
+}
+
+// Credentials of the admin user of a new cluster
+type Credentials struct {
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+}
+
+func (s *ClusterSpec) Key() string { return s.Name }
+
+// Options returns the options building the API spec of the cluster
+func (s *ClusterSpec) Options() ybm.ClusterSpecOptions {
+	opts := ybm.ClusterSpecOptions{
+		Name:                s.Name,
+		CloudProvider:       s.CloudProvider,
+		Tier:                s.ClusterTier,
+		FaultTolerance:      s.FaultTolerance,
+		NumFaultsToTolerate: s.NumFaultsToTolerate,
+		ClusterType:         s.ClusterType,
+		DatabaseVersion:     s.DatabaseVersion,
+	}
+	for _, region := range s.Regions {
+		opts.Regions = append(opts.Regions, ybm.RegionSpec{
+			Region:                     region.Region,
+			NumNodes:                   region.NumNodes,
+			Vpc:                        region.Vpc,
+			NumCores:                   region.NumCores,
+			DiskSizeGb:                 region.DiskSizeGb,
+			DiskIops:                   region.DiskIops,
+			BackupReplicationGcpTarget: region.BackupReplicationGcpTarget,
+		})
+	}
+	return opts
+}
+
+// NetworkAllowListSpec describes a network allow list
+type NetworkAllowListSpec struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	IpAddresses []string `json:"ip-addresses" yaml:"ip-addresses"`
+}
+
+func (s *NetworkAllowListSpec) Key() string { return s.Name }
+
+// VpcSpec describes a VPC, either with a global CIDR (GCP only) or a CIDR per region
+type VpcSpec struct {
+	Name          string          `json:"name" yaml:"name"`
+	CloudProvider string          `json:"cloud-provider" yaml:"cloud-provider"`
+	GlobalCidr    string          `json:"global-cidr,omitempty" yaml:"global-cidr,omitempty"`
+	Regions       []VpcRegionSpec `json:"regions,omitempty" yaml:"regions,omitempty"`
+}
+
+// VpcRegionSpec is the CIDR of a VPC in one region
+type VpcRegionSpec struct {
+	Region string `json:"region" yaml:"region"`
+	Cidr   string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+}
+
+func (s *VpcSpec) Key() string { return s.Name }
+
+// VpcPeeringSpec describes the peering of a VPC with an application VPC
+type VpcPeeringSpec struct {
+	Name           string             `json:"name" yaml:"name"`
+	Vpc            string             `json:"vpc" yaml:"vpc"`
+	ApplicationVpc ApplicationVpcSpec `json:"application-vpc" yaml:"application-vpc"`
+}
+
+// ApplicationVpcSpec is the application side of a VPC peering
+type ApplicationVpcSpec struct {
+	CloudProvider string `json:"cloud-provider" yaml:"cloud-provider"`
+	// Project is the AWS account ID or the GCP project ID
+	Project string `json:"project" yaml:"project"`
+	// Vpc is the AWS VPC ID or the GCP VPC name
+	Vpc    string `json:"vpc" yaml:"vpc"`
+	Region string `json:"region,omitempty" yaml:"region,omitempty"`
+	Cidr   string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+}
+
+func (s *VpcPeeringSpec) Key() string { return s.Name }
+
+// IntegrationSpec describes an integration, Config holds the keys of the
+// --<type>-spec flag of integration create
+type IntegrationSpec struct {
+	Name   string            `json:"name" yaml:"name"`
+	Type   string            `json:"type" yaml:"type"`
+	Config map[string]string `json:"config" yaml:"config"`
+}
+
+func (s *IntegrationSpec) Key() string { return s.Name }
+
+// IntegrationSecrets lists the config keys of every integration type that the
+// API does not return
+var IntegrationSecrets = map[string][]string{
+	"DATADOG":     {"api-key"},
+	"GRAFANA":     {"access-policy-token"},
+	"SUMOLOGIC":   {"access-key", "access-id", "installation-token"},
+	"GOOGLECLOUD": {"cred-filepath"},
+	"NEWRELIC":    {"license-key"},
+	"AWS_S3":      {"access-key-id", "secret-access-key"},
+}
+
+// BackupPolicySpec describes the backup policy of a cluster. The full backups
+// run either every FullBackupFrequencyInDays or on the UTC CronExpression.
+type BackupPolicySpec struct {
+	Cluster                             string `json:"cluster" yaml:"cluster"`
+	RetentionPeriodInDays               int32  `json:"retention-period-in-days,omitempty" yaml:"retention-period-in-days,omitempty"`
+	FullBackupFrequencyInDays           int32  `json:"full-backup-frequency-in-days,omitempty" yaml:"full-backup-frequency-in-days,omitempty"`
+	CronExpression                      string `json:"cron-expression,omitempty" yaml:"cron-expression,omitempty"`
+	IncrementalBackupFrequencyInMinutes int32  `json:"incremental-backup-frequency-in-minutes,omitempty" yaml:"incremental-backup-frequency-in-minutes,omitempty"`
+	Enabled                             *bool  `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+}
+
+func (s *BackupPolicySpec) Key() string { return s.Cluster }
+
+// PitrConfigSpec describes the PITR config of a namespace
+type PitrConfigSpec struct {
+	Cluster               string `json:"cluster" yaml:"cluster"`
+	NamespaceName         string `json:"namespace-name" yaml:"namespace-name"`
+	NamespaceType         string `json:"namespace-type" yaml:"namespace-type"`
+	RetentionPeriodInDays int32  `json:"retention-period-in-days" yaml:"retention-period-in-days"`
+}
+
+func (s *PitrConfigSpec) Key() string {
+	return fmt.Sprintf("%s/%s/%s", s.Cluster, s.NamespaceType, s.NamespaceName)
+}
+
+// ResourceSpec describes a resource of a read only kind. Settings hold its API
+// spec with the secrets replaced by placeholders.
+type ResourceSpec struct {
+	// Name is empty for the settings of a cluster, such as its encryption
+	Name     string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	Cluster  string                 `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty" yaml:"settings,omitempty"`
+}
+
+func (s *ResourceSpec) Key() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Cluster
+}
+
+// NewSpec returns an empty spec of kind
+func NewSpec(kind Kind) (Spec, error) {
+	switch kind {
+	case KindCluster:
+		return &ClusterSpec{}, nil
+	case KindNetworkAllowList:
+		return &NetworkAllowListSpec{}, nil
+	case KindVpc:
+		return &VpcSpec{}, nil
+	case KindVpcPeering:
+		return &VpcPeeringSpec{}, nil
+	case KindIntegration:
+		return &IntegrationSpec{}, nil
+	case KindBackupPolicy:
+		return &BackupPolicySpec{}, nil
+	case KindPitrConfig:
+		return &PitrConfigSpec{}, nil
+	case KindRole, KindClusterEncryption, KindDbQueryLogging, KindDbAuditLogging, KindMetricsExporter, KindClusterEndpoints, KindDrConfig, KindCdcSink, KindCdcStream:
+		return &ResourceSpec{}, nil
+	}
+	kinds := make([]string, 0, len(Kinds))
+	for _, k := range Kinds {
+		kinds = append(kinds, string(k))
+	}
+	return nil, fmt.Errorf("unknown kind %q, the kinds are %s", kind, strings.Join(kinds, ", "))
+}
+
+// placeholder matches the ${NAME} references to environment variables
+var placeholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandEnv replaces the ${NAME} placeholders of a manifest with the value of
+// the environment variables, so that secrets stay out of the files
+func ExpandEnv(data []byte) ([]byte, error) {
+	var missing []string
+	expanded := placeholder.ReplaceAllFunc(data, func(match []byte) []byte {
+		name := string(placeholder.FindSubmatch(match)[1])
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return []byte(value)
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("environment variables %s are not set", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+// expandSetEnv replaces the placeholders of the environment variables that are
+// set and keeps the others
+func expandSetEnv(data []byte) ([]byte, error) {
+	return placeholder.ReplaceAllFunc(data, func(match []byte) []byte {
+		if value, ok := os.LookupEnv(string(placeholder.FindSubmatch(match)[1])); ok {
+			return []byte(value)
+		}
+		return match
+	}), nil
+}
+
+// Parse reads the manifests of a YAML or JSON document stream. A document is
+// either one manifest or a list of manifests.
+func Parse(data []byte, source string) ([]Manifest, error) {
+	return parse(data, source, ExpandEnv)
+}
+
+func parse(data []byte, source string, expand func([]byte) ([]byte, error)) ([]Manifest, error) {
+	data, err := expand(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	var manifests []Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		nodes := []*yaml.Node{&document}
+		if len(document.Content) == 1 && document.Content[0].Kind == yaml.SequenceNode {
+			nodes = document.Content[0].Content
+		}
+		for _, node := range nodes {
+			m, err := decode(node)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", source, node.Line, err)
+			}
+			if m == nil {
+				continue
+			}
+			m.Source = source
+			manifests = append(manifests, *m)
+		}
+	}
+	return manifests, nil
+}
+
+// decode reads one manifest, nil for an empty document
+func decode(node *yaml.Node) (*Manifest, error) {
+	var raw struct {
+		Kind Kind      `yaml:"kind"`
+		Spec yaml.Node `yaml:"spec"`
+	}
+	if err := node.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if raw.Kind == "" && raw.Spec.Kind == 0 {
+		return nil, nil
+	}
+	spec, err := NewSpec(raw.Kind)
+	if err != nil {
+		return nil, err
+	}
+	// Decode the spec again from its text to reject unknown fields
+	specData, err := yaml.Marshal(&raw.Spec)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(specData))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(spec); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid %s spec: %w", raw.Kind, err)
+	}
+	if err := validate(raw.Kind, spec); err != nil {
+		return nil, err
+	}
+	return &Manifest{Kind: raw.Kind, Spec: spec}, nil
+}
+
+// validate checks that the fields identifying the resource are set
+func validate(kind Kind, spec Spec) error {
+	switch s := spec.(type) {
+	case *PitrConfigSpec:
+		if s.Cluster == "" || s.NamespaceName == "" || s.NamespaceType == "" {
+			return fmt.Errorf("%s needs a cluster, a namespace-name and a namespace-type", kind)
+		}
+		if s.NamespaceType != "YSQL" && s.NamespaceType != "YCQL" {
+			return fmt.Errorf("%s namespace-type must be YSQL or YCQL", kind)
+		}
+	case *IntegrationSpec:
+		if s.Name == "" || s.Type == "" {
+			return fmt.Errorf("%s needs a name and a type", kind)
+		}
+		// The API returns the types upper case
+		s.Type = strings.ToUpper(s.Type)
+	case *BackupPolicySpec:
+		if s.Cluster == "" {
+			return fmt.Errorf("%s has no cluster", kind)
+		}
+	case *ResourceSpec:
+		if s.Key() == "" {
+			return fmt.Errorf("%s needs a name or a cluster", kind)
+		}
+	default:
+		if spec.Key() == "" {
+			return fmt.Errorf("%s has no name", kind)
+		}
+	}
+	return nil
+}
+
+// Load reads the manifests of a file, or of every .yaml, .yml and .json file
+// under a directory. The manifests are sorted in the order they are applied.
+func Load(paths ...string) ([]Manifest, error) {
+	return load(ExpandEnv, paths...)
+}
+
+// LoadBaseline reads manifests like Load, but keeps the placeholders of the
+// environment variables that are not set. A baseline is compared without its
+// secrets so they do not have to be set.
+func LoadBaseline(paths ...string) ([]Manifest, error) {
+	return load(expandSetEnv, paths...)
+}
+
+func load(expand func([]byte) ([]byte, error), paths ...string) ([]Manifest, error) {
+	var manifests []Manifest
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			parsed, err := parse(data, file, expand)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, parsed...)
+		}
+	}
+	seen := map[string]string{}
+	for _, m := range manifests {
+		if source, ok := seen[m.String()]; ok {
+			return nil, fmt.Errorf("%s is declared in both %s and %s", m, source, m.Source)
+		}
+		seen[m.String()] = m.Source
+	}
+	Sort(manifests)
+	return manifests, nil
+}
+
+// manifestFiles returns path, or the manifest files under the directory path
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(file string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, file)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifest found in %s", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Sort orders manifests by kind, in the order they are applied
+func Sort(manifests []Manifest) {
+	rank := map[Kind]int{}
+	for i, kind := range Kinds {
+		rank[kind] = i
+	}
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return rank[manifests[i].Kind] < rank[manifests[j].Kind]
+	})
+}
@@ -0,0 +1,205 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest", func() {
+
+	Context("When parsing", func() {
+
+		It("should read every document and list of a stream", func() {
+			manifests, err := Parse([]byte(`kind: Cluster
+spec:
+  name: prod
+  regions:
+    - region: us-west-2
+      num-nodes: 3
+---
+- kind: NetworkAllowList
+  spec:
+    name: office
+    ip-addresses: [203.0.113.0/24]
+- kind: Integration
+  spec:
+    name: dd
+    type: datadog
+    config:
+      site: US1
+`), "prod.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(HaveLen(3))
+			Expect(manifests[0].Spec).To(Equal(&ClusterSpec{Name: "prod", Regions: []RegionSpec{{Region: "us-west-2", NumNodes: 3}}}))
+			Expect(manifests[1].String()).To(Equal("NetworkAllowList office"))
+			Expect(manifests[2].Spec.(*IntegrationSpec).Type).To(Equal("DATADOG"))
+			Expect(manifests[2].Source).To(Equal("prod.yaml"))
+		})
+
+		It("should replace placeholders with environment variables", func() {
+			os.Setenv("YBM_MANIFEST_TEST_PASSWORD", "s3cr3t")
+			defer os.Unsetenv("YBM_MANIFEST_TEST_PASSWORD")
+			manifests, err := Parse([]byte(`{"kind": "Cluster", "spec": {"name": "prod", "credentials": {"username": "admin", "password": "${YBM_MANIFEST_TEST_PASSWORD}"}}}`), "prod.json")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests[0].Spec.(*ClusterSpec).Credentials.Password).To(Equal("s3cr3t"))
+
+			_, err = Parse([]byte(`{"kind": "Cluster", "spec": {"name": "${YBM_MANIFEST_TEST_UNSET}"}}`), "prod.json")
+			Expect(err).To(MatchError("prod.json: environment variables YBM_MANIFEST_TEST_UNSET are not set"))
+		})
+
+		It("should reject unknown fields and missing names", func() {
+			_, err := Parse([]byte("kind: Vpc\nspec:\n  name: vpc\n  cidr: 10.0.0.0/16\n"), "vpc.yaml")
+			Expect(err).To(MatchError(ContainSubstring("field cidr not found")))
+
+			_, err = Parse([]byte("kind: BackupPolicy\nspec:\n  retention-period-in-days: 8\n"), "backup.yaml")
+			Expect(err).To(MatchError(ContainSubstring("BackupPolicy has no cluster")))
+		})
+
+		It("should load a directory in apply order and reject duplicates", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kind: BackupPolicy\nspec:\n  cluster: prod\n"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "b.yml"), []byte("kind: Cluster\nspec:\n  name: prod\n"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a manifest"), 0600)).To(Succeed())
+			manifests, err := Load(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(HaveLen(2))
+			Expect(manifests[0].Kind).To(Equal(KindCluster))
+			Expect(manifests[1].Kind).To(Equal(KindBackupPolicy))
+
+			Expect(os.WriteFile(filepath.Join(dir, "c.json"), []byte(`{"kind": "Cluster", "spec": {"name": "prod"}}`), 0600)).To(Succeed())
+			_, err = Load(dir)
+			Expect(err).To(MatchError(ContainSubstring("Cluster prod is declared in both")))
+		})
+	})
+
+	Context("When removing secrets", func() {
+
+		It("should replace secrets with placeholders", func() {
+			settings := settingsOf(map[string]interface{}{
+				"name": "kafka",
+				"kafka": map[string]interface{}{
+					"hostname": "kafka.example.com",
+					"password": "s3cr3t",
+				},
+			}, "kafka sink", "name")
+			Expect(settings).To(Equal(map[string]interface{}{
+				"kafka": map[string]interface{}{
+					"hostname": "kafka.example.com",
+					"password": "${KAFKA_SINK_KAFKA_PASSWORD}",
+				},
+			}))
+		})
+
+		It("should add placeholders for the secrets of integrations", func() {
+			spec := WithSecretPlaceholders(&IntegrationSpec{Name: "dd-prod", Type: "DATADOG", Config: map[string]string{"site": "US1"}})
+			Expect(spec.Config).To(Equal(map[string]string{"site": "US1", "api-key": "${DD_PROD_API_KEY}"}))
+		})
+
+		It("should not compare secrets", func() {
+			live := &ResourceSpec{Name: "kafka", Settings: map[string]interface{}{"password": "${KAFKA_PASSWORD}", "port": 9092}}
+			desired := &ResourceSpec{Name: "kafka", Settings: map[string]interface{}{"password": "other", "port": 9093}}
+			Expect(Paths(Diff(desired, live))).To(Equal([]string{"settings.port"}))
+		})
+	})
+
+	Context("When detecting drift", func() {
+
+		It("should report added, removed and modified resources of the baseline kinds", func() {
+			enabled, disabled := true, false
+			baseline := []Manifest{
+				{Kind: KindNetworkAllowList, Spec: &NetworkAllowListSpec{Name: "office", IpAddresses: []string{"10.0.0.1/32"}}},
+				{Kind: KindNetworkAllowList, Spec: &NetworkAllowListSpec{Name: "vpn", IpAddresses: []string{"10.0.0.2/32"}}},
+				{Kind: KindBackupPolicy, Spec: &BackupPolicySpec{Cluster: "prod", Enabled: &enabled}},
+			}
+			live := []Manifest{
+				{Kind: KindNetworkAllowList, Spec: &NetworkAllowListSpec{Name: "office", IpAddresses: []string{"10.0.0.1/32"}}},
+				{Kind: KindNetworkAllowList, Spec: &NetworkAllowListSpec{Name: "anywhere", IpAddresses: []string{"0.0.0.0/0"}}},
+				{Kind: KindBackupPolicy, Spec: &BackupPolicySpec{Cluster: "prod", Enabled: &disabled}},
+				{Kind: KindVpc, Spec: &VpcSpec{Name: "not-in-baseline"}},
+			}
+			Expect(Drift(baseline, live)).To(Equal([]ResourceDiff{
+				{Kind: KindNetworkAllowList, Name: "vpn", Action: DriftRemoved},
+				{Kind: KindNetworkAllowList, Name: "anywhere", Action: DriftAdded},
+				{Kind: KindBackupPolicy, Name: "prod", Action: DriftModified, Changes: []Change{{Path: "enabled", Live: "false", Desired: "true"}}},
+			}))
+		})
+
+		It("should report a public endpoint added to a cluster", func() {
+			baseline := []Manifest{
+				{Kind: KindClusterEndpoints, Spec: &ResourceSpec{Cluster: "prod", Settings: map[string]interface{}{
+					"private": []interface{}{"private.prod.example.com"}, "public": []interface{}{}}}},
+			}
+			live := []Manifest{
+				{Kind: KindClusterEndpoints, Spec: &ResourceSpec{Cluster: "prod", Settings: map[string]interface{}{
+					"private": []interface{}{"private.prod.example.com"}, "public": []interface{}{"public.prod.example.com"}}}},
+			}
+			Expect(Drift(baseline, live)).To(Equal([]ResourceDiff{
+				{Kind: KindClusterEndpoints, Name: "prod", Action: DriftModified, Changes: []Change{{Path: "settings.public", Live: "public.prod.example.com"}}},
+			}))
+		})
+	})
+
+	Context("When diffing", func() {
+
+		It("should only compare the fields of the desired spec", func() {
+			live := &ClusterSpec{Name: "prod", CloudProvider: "AWS", DatabaseVersion: "Production",
+				Regions: []RegionSpec{{Region: "us-west-2", NumNodes: 3, NumCores: 4, DiskSizeGb: 100}}}
+			desired := &ClusterSpec{Name: "prod", Regions: []RegionSpec{{Region: "us-west-2", NumNodes: 3}},
+				Credentials: &Credentials{Username: "admin", Password: "s3cr3t"}}
+			Expect(Diff(desired, live)).To(BeEmpty())
+		})
+
+		It("should report changed, added and removed regions", func() {
+			live := &ClusterSpec{Name: "prod", DatabaseVersion: "Production", Regions: []RegionSpec{
+				{Region: "us-west-2", NumNodes: 1}, {Region: "us-east-1", NumNodes: 1}}}
+			desired := &ClusterSpec{Name: "prod", DatabaseVersion: "Innovation", Regions: []RegionSpec{
+				{Region: "us-west-2", NumNodes: 3}, {Region: "eu-west-1", NumNodes: 1}}}
+			Expect(Diff(desired, live)).To(Equal([]Change{
+				{Path: "database-version", Live: "Production", Desired: "Innovation"},
+				{Path: "regions[eu-west-1].num-nodes", Desired: "1"},
+				{Path: "regions[eu-west-1].region", Desired: "eu-west-1"},
+				{Path: "regions[us-east-1].num-nodes", Live: "1"},
+				{Path: "regions[us-east-1].region", Live: "us-east-1"},
+				{Path: "regions[us-west-2].num-nodes", Live: "1", Desired: "3"},
+			}))
+		})
+
+		It("should leave the regions and the nodes alone when the desired spec leaves them out", func() {
+			live := &ClusterSpec{Name: "prod", NetworkAllowLists: []string{"office"}, Regions: []RegionSpec{
+				{Region: "us-west-2", NumNodes: 3}, {Region: "us-east-1", NumNodes: 3}}}
+			desired := &ClusterSpec{Name: "prod", NetworkAllowLists: []string{"office", "vpn"}}
+			Expect(Paths(Diff(desired, live))).To(Equal([]string{"network-allow-lists"}))
+
+			desired = &ClusterSpec{Name: "prod", Regions: []RegionSpec{{Region: "us-west-2", NumCores: 4}, {Region: "us-east-1"}}}
+			Expect(Paths(Diff(desired, live))).To(Equal([]string{"regions[us-west-2].num-cores"}))
+		})
+
+		It("should compare lists of values whatever their order and ignore secrets", func() {
+			live := &NetworkAllowListSpec{Name: "office", IpAddresses: []string{"10.0.0.1/32", "10.0.0.2/32"}}
+			desired := &NetworkAllowListSpec{Name: "office", IpAddresses: []string{"10.0.0.2/32", "10.0.0.1/32"}}
+			Expect(Diff(desired, live)).To(BeEmpty())
+
+			liveIntegration := &IntegrationSpec{Name: "dd", Type: "DATADOG", Config: map[string]string{"site": "US1"}}
+			desiredIntegration := &IntegrationSpec{Name: "dd", Type: "DATADOG", Config: map[string]string{"site": "EU1", "api-key": "key"}}
+			Expect(Paths(Diff(desiredIntegration, liveIntegration))).To(Equal([]string{"config.site"}))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	ybmAuthClient "github.com/yugabyte/ybm-cli/internal/client"
+)
+
+// The actions bringing a live resource to its manifest
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+// ResourceDiff is the action and the changes bringing a live resource to its
+// manifest
+type ResourceDiff struct {
+	Kind    Kind     `json:"kind"`
+	Name    string   `json:"name"`
+	Action  string   `json:"action"`
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Plan compares the manifests with the live resources and returns the ones
+// that apply would create or update, in the order of the manifests
+func Plan(r *Reader, manifests []Manifest) ([]ResourceDiff, error) {
+	diffs := []ResourceDiff{}
+	for _, m := range manifests {
+		state, err := r.Live(m)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", m, ybmAuthClient.GetApiErrorDetails(err))
+		}
+		if state == nil {
+			diffs = append(diffs, ResourceDiff{Kind: m.Kind, Name: m.Spec.Key(), Action: ActionCreate})
+			continue
+		}
+		if changes := Diff(m.Spec, state.Spec); len(changes) > 0 {
+			diffs = append(diffs, ResourceDiff{Kind: m.Kind, Name: m.Spec.Key(), Action: ActionUpdate, Changes: changes})
+		}
+	}
+	return diffs, nil
+}
@@ -0,0 +1,78 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/yugabyte/ybm-cli/internal/redact"
+)
+
+// nonAlphanumeric matches what an environment variable name cannot hold
+var nonAlphanumeric = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// Placeholder returns the ${NAME} reference to the environment variable that
+// should hold a secret, named after the parts, such as ${DATADOG_PROD_API_KEY}
+func Placeholder(parts ...string) string {
+	name := nonAlphanumeric.ReplaceAllString(strings.ToUpper(strings.Join(parts, "_")), "_")
+	return "${" + strings.Trim(name, "_") + "}"
+}
+
+// WithSecretPlaceholders returns a copy of the spec of an integration with a
+// placeholder for every secret the API does not return
+func WithSecretPlaceholders(spec *IntegrationSpec) *IntegrationSpec {
+	withSecrets := *spec
+	withSecrets.Config = map[string]string{}
+	for key, value := range spec.Config {
+		withSecrets.Config[key] = value
+	}
+	for _, secret := range IntegrationSecrets[spec.Type] {
+		withSecrets.Config[secret] = Placeholder(spec.Name, secret)
+	}
+	return &withSecrets
+}
+
+// settingsOf returns the settings of an API object, without the dropped fields
+// and with placeholders named after the prefix in place of its secrets
+func settingsOf(value interface{}, prefix string, drop ...string) map[string]interface{} {
+	settings := map[string]interface{}{}
+	data, err := json.Marshal(value)
+	if err != nil || json.Unmarshal(data, &settings) != nil {
+		return settings
+	}
+	for _, key := range drop {
+		delete(settings, key)
+	}
+	withPlaceholders(settings, prefix)
+	return settings
+}
+
+// withPlaceholders replaces the secret values of settings with placeholders
+func withPlaceholders(settings map[string]interface{}, prefix string) {
+	for key, value := range settings {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			withPlaceholders(v, prefix+"_"+key)
+		case nil:
+		default:
+			if redact.IsSecretKey(key) {
+				settings[key] = Placeholder(prefix, key)
+			}
+		}
+	}
+}
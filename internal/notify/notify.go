@@ -0,0 +1,204 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package notify sends a notification when a waited operation completes
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Timeout bounds the delivery of one notification
+const Timeout = 10 * time.Second
+
+// Event describes the outcome of a waited operation
+type Event struct {
+	EntityType string    `json:"entity_type,omitempty"`
+	EntityID   string    `json:"entity_id,omitempty"`
+	TaskID     string    `json:"task_id,omitempty"`
+	TaskType   string    `json:"task_type,omitempty"`
+	State      string    `json:"state"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	// Duration is in seconds
+	Duration float64 `json:"duration_seconds"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// Summary is a one line description of the event
+func (e Event) Summary() string {
+	subject := e.TaskType
+	if subject == "" {
+		subject = "Task " + e.TaskID
+	}
+	if e.EntityID != "" {
+		subject += fmt.Sprintf(" on %s %s", strings.ToLower(e.EntityType), e.EntityID)
+	}
+	summary := fmt.Sprintf("%s: %s after %s", subject, e.State, time.Duration(e.Duration*float64(time.Second)).Round(time.Second))
+	if e.Error != "" {
+		summary += " (" + e.Error + ")"
+	}
+	return summary
+}
+
+// Target receives notifications
+type Target interface {
+	Notify(ctx context.Context, event Event) error
+	String() string
+}
+
+// ParseTarget parses a --notify target:
+//   - webhook=<url>, or a bare http(s) URL, POSTs the event as JSON
+//   - slack=<url>, or a bare hooks.slack.com URL, POSTs a Slack incoming-webhook message
+//   - exec=<command> runs a local command with the event as JSON on stdin and
+//     in YBM_NOTIFY_* environment variables
+func ParseTarget(target string, client *http.Client) (Target, error) {
+	kind, value, found := strings.Cut(target, "=")
+	if !found || strings.Contains(kind, ":") {
+		kind, value = "webhook", target
+		if u, err := url.Parse(target); err == nil && u.Host == "hooks.slack.com" {
+			kind = "slack"
+		}
+	}
+	switch kind {
+	case "webhook", "slack":
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("--notify %s must be an http or https URL, got '%s'", kind, value)
+		}
+		return &webhook{url: value, slack: kind == "slack", client: client}, nil
+	case "exec":
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("--notify exec needs a command")
+		}
+		return &command{command: value}, nil
+	default:
+		return nil, fmt.Errorf("unknown --notify target '%s', expected webhook=<url>, slack=<url> or exec=<command>", kind)
+	}
+}
+
+// ParseTargets parses every --notify target
+func ParseTargets(targets []string, client *http.Client) ([]Target, error) {
+	parsed := []Target{}
+	for _, target := range targets {
+		t, err := ParseTarget(target, client)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, t)
+	}
+	return parsed, nil
+}
+
+// Send notifies every target, returning the errors of the targets which failed
+func Send(targets []Target, event Event) []error {
+	errs := []error{}
+	for _, target := range targets {
+		ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+		if err := target.Notify(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("could not notify %s: %w", target, err))
+		}
+		cancel()
+	}
+	return errs
+}
+
+type webhook struct {
+	url    string
+	slack  bool
+	client *http.Client
+}
+
+func (w *webhook) String() string {
+	// The path of a webhook URL is usually its secret
+	u, err := url.Parse(w.url)
+	if err != nil {
+		return "webhook"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+func (w *webhook) Notify(ctx context.Context, event Event) error {
+	var payload interface{} = event
+	if w.slack {
+		payload = map[string]string{"text": "ybm: " + event.Summary()}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	client := w.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("%s", response.Status)
+	}
+	return nil
+}
+
+type command struct {
+	command string
+}
+
+func (c *command) String() string {
+	return "exec " + c.command
+}
+
+func (c *command) Notify(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", c.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", c.command)
+	}
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"YBM_NOTIFY_ENTITY_TYPE="+event.EntityType,
+		"YBM_NOTIFY_ENTITY_ID="+event.EntityID,
+		"YBM_NOTIFY_TASK_ID="+event.TaskID,
+		"YBM_NOTIFY_TASK_TYPE="+event.TaskType,
+		"YBM_NOTIFY_STATE="+event.State,
+		fmt.Sprintf("YBM_NOTIFY_DURATION=%.0f", event.Duration),
+		"YBM_NOTIFY_ERROR="+event.Error,
+	)
+	return cmd.Run()
+}
@@ -0,0 +1,105 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package notify_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/yugabyte/ybm-cli/internal/notify"
+)
+
+var _ = Describe("Notify", func() {
+	var (
+		server   *httptest.Server
+		received []byte
+		event    notify.Event
+	)
+
+	BeforeEach(func() {
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+		}))
+		started := time.Date(2024, 3, 5, 3, 33, 23, 0, time.UTC)
+		event = notify.Event{
+			EntityType: "CLUSTER",
+			EntityID:   "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8",
+			TaskType:   "EDIT_CLUSTER",
+			State:      "SUCCEEDED",
+			StartedAt:  started,
+			FinishedAt: started.Add(12 * time.Minute),
+			Duration:   720,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should reject unknown targets", func() {
+		_, err := notify.ParseTarget("pager=42", nil)
+		Expect(err).To(MatchError("unknown --notify target 'pager', expected webhook=<url>, slack=<url> or exec=<command>"))
+		_, err = notify.ParseTarget("webhook=not-a-url", nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should post the event to a webhook", func() {
+		target, err := notify.ParseTarget(server.URL+"/hook?token=a=b", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notify.Send([]notify.Target{target}, event)).To(BeEmpty())
+		var payload map[string]interface{}
+		Expect(json.Unmarshal(received, &payload)).To(Succeed())
+		Expect(payload["state"]).To(Equal("SUCCEEDED"))
+		Expect(payload["task_type"]).To(Equal("EDIT_CLUSTER"))
+		Expect(payload["duration_seconds"]).To(BeEquivalentTo(720))
+	})
+
+	It("should post a Slack message", func() {
+		target, err := notify.ParseTarget("slack="+server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notify.Send([]notify.Target{target}, event)).To(BeEmpty())
+		Expect(string(received)).To(Equal(`{"text":"ybm: EDIT_CLUSTER on cluster 5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8: SUCCEEDED after 12m0s"}`))
+	})
+
+	It("should run a command with the event", func() {
+		if runtime.GOOS == "windows" {
+			Skip("the hook uses a POSIX shell")
+		}
+		out := filepath.Join(GinkgoT().TempDir(), "event")
+		target, err := notify.ParseTarget("exec=cat > "+out+"; echo $YBM_NOTIFY_STATE >> "+out, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notify.Send([]notify.Target{target}, event)).To(BeEmpty())
+		content, err := os.ReadFile(out)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`"entity_id":"5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8"`))
+		Expect(string(content)).To(HaveSuffix("SUCCEEDED\n"))
+	})
+
+	It("should report the targets which failed", func() {
+		target, err := notify.ParseTarget("exec=exit 3", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notify.Send([]notify.Target{target}, event)).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,52 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"regexp"
+)
+
+// Mask replaces every secret value found in the output
+const Mask = "********"
+
+// secretWords are the words in the name of a field holding a secret
+const secretWords = `password|passwd|secret|token|api_?key|access_?key|private_?key|license_?key`
+
+var (
+	secretKeyPattern  = regexp.MustCompile(`(?i)(?:` + secretWords + `)`)
+	privateKeyPattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+	headerPattern     = regexp.MustCompile(`(?i)\b(authorization|proxy-authorization|cookie|set-cookie|x-api-key)(["']?\s*[:=]\s*\[?"?)([^\r\n"\]]+)`)
+	jsonPattern       = regexp.MustCompile(`(?i)("[\w-]*(?:` + secretWords + `)[\w-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	structPattern     = regexp.MustCompile(`\b(\w*(?:Password|Passwd|Secret|Token|ApiKey|AccessKey|PrivateKey)\w*):([^\s{}\[\]]+)`)
+	flagPattern       = regexp.MustCompile(`(?i)\b((?:password|aws-secret-key|aws-access-key|azu-client-secret)=)([^,\s]+)`)
+)
+
+// IsSecretKey tells whether the field called key holds a secret, such as the
+// password of a CDC sink or the private key of a GCP service account
+func IsSecretKey(key string) bool {
+	return secretKeyPattern.MatchString(key)
+}
+
+// String masks credentials, keys, tokens and authorization headers found in s.
+// It understands JSON payloads, HTTP dumps, Go %+v struct dumps and CLI key=value pairs.
+func String(s string) string {
+	s = privateKeyPattern.ReplaceAllString(s, Mask)
+	s = headerPattern.ReplaceAllString(s, "${1}${2}"+Mask)
+	s = jsonPattern.ReplaceAllString(s, `${1}"`+Mask+`"`)
+	s = structPattern.ReplaceAllString(s, "${1}:"+Mask)
+	s = flagPattern.ReplaceAllString(s, "${1}"+Mask)
+	return s
+}
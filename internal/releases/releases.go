@@ -117,7 +117,11 @@ func GetLatestRelease() (string, error) {
 func FetchLatestReleaseFromGithub() (string, error) {
 
 	logrus.Debugln("Fetching the latest release from github")
-	client := github.NewClient(nil)
+	httpClient, err := ybmAuthClient.NewHTTPClient()
+	if err != nil {
+		return "", err
+	}
+	client := github.NewClient(httpClient)
 	// Fetching the latest 10 releases
 	opts := &github.ListOptions{
 		Page:    1,
@@ -0,0 +1,133 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trace
+
+import (
+	"net/url"
+	"time"
+)
+
+// HAR 1.2 structures, see http://www.softwareishard.com/blog/har-12-spec/
+type harArchive struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string       `json:"method"`
+	URL         string       `json:"url"`
+	HTTPVersion string       `json:"httpVersion"`
+	Cookies     []struct{}   `json:"cookies"`
+	Headers     []Header     `json:"headers"`
+	QueryString []Header     `json:"queryString"`
+	PostData    *harPostData `json:"postData,omitempty"`
+	HeadersSize int          `json:"headersSize"`
+	BodySize    int          `json:"bodySize"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harResponse struct {
+	Status      int        `json:"status"`
+	StatusText  string     `json:"statusText"`
+	HTTPVersion string     `json:"httpVersion"`
+	Cookies     []struct{} `json:"cookies"`
+	Headers     []Header   `json:"headers"`
+	Content     harContent `json:"content"`
+	RedirectURL string     `json:"redirectURL"`
+	HeadersSize int        `json:"headersSize"`
+	BodySize    int        `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+func newHarEntry(e Entry) harEntry {
+	query := []Header{}
+	if u, err := url.Parse(e.URL); err == nil {
+		for name, values := range u.Query() {
+			for _, value := range values {
+				query = append(query, Header{Name: name, Value: value})
+			}
+		}
+	}
+	request := harRequest{
+		Method:      e.Method,
+		URL:         e.URL,
+		HTTPVersion: "HTTP/1.1",
+		Cookies:     []struct{}{},
+		Headers:     e.RequestHeaders,
+		QueryString: query,
+		HeadersSize: -1,
+		BodySize:    len(e.RequestBody),
+	}
+	if e.RequestBody != "" {
+		request.PostData = &harPostData{MimeType: "application/json", Text: e.RequestBody}
+	}
+	responseHeaders := e.ResponseHeaders
+	if responseHeaders == nil {
+		responseHeaders = []Header{}
+	}
+	return harEntry{
+		StartedDateTime: e.StartedDateTime.Format(time.RFC3339Nano),
+		Time:            e.DurationMs,
+		Request:         request,
+		Response: harResponse{
+			Status:      e.Status,
+			StatusText:  e.StatusText,
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     []struct{}{},
+			Headers:     responseHeaders,
+			Content:     harContent{Size: len(e.ResponseBody), MimeType: e.MimeType, Text: e.ResponseBody},
+			HeadersSize: -1,
+			BodySize:    len(e.ResponseBody),
+		},
+		Timings: harTimings{Send: 0, Wait: e.DurationMs, Receive: 0},
+		Comment: e.Error,
+	}
+}
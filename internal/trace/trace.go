@@ -0,0 +1,220 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yugabyte/ybm-cli/internal/redact"
+)
+
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// Recorder writes every HTTP exchange going through its transport to a file,
+// either as a HAR 1.2 archive (.har extension) or as JSON lines.
+type Recorder struct {
+	mu      sync.Mutex
+	path    string
+	har     bool
+	version string
+	entries []Entry
+	file    *os.File
+	closed  bool
+}
+
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Entry is a single request/response exchange
+type Entry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	DurationMs      float64   `json:"durationMs"`
+	Method          string    `json:"method"`
+	URL             string    `json:"url"`
+	Status          int       `json:"status"`
+	StatusText      string    `json:"statusText,omitempty"`
+	RequestHeaders  []Header  `json:"requestHeaders"`
+	RequestBody     string    `json:"requestBody,omitempty"`
+	ResponseHeaders []Header  `json:"responseHeaders,omitempty"`
+	ResponseBody    string    `json:"responseBody,omitempty"`
+	MimeType        string    `json:"mimeType,omitempty"`
+	Error           string    `json:"error,omitempty"`
+}
+
+func NewRecorder(path string, version string) (*Recorder, error) {
+	r := &Recorder{
+		path:    path,
+		har:     strings.EqualFold(filepath.Ext(path), ".har"),
+		version: version,
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open trace file %s: %w", path, err)
+	}
+	if r.har {
+		// The archive is only written by Close, the file is created now so
+		// that a wrong path fails before any request is sent
+		file.Close()
+		return r, nil
+	}
+	r.file = file
+	return r, nil
+}
+
+// Transport wraps next so that every exchange is recorded
+func (r *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{next: next, recorder: r}
+}
+
+func (r *Recorder) Record(entry Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.har {
+		r.entries = append(r.entries, entry)
+		return nil
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = r.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close writes the HAR archive with all the exchanges, or closes the JSON lines
+// file. It must be called once the command is done, further calls do nothing.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if r.har {
+		return r.writeHar()
+	}
+	return r.file.Close()
+}
+
+func (r *Recorder) writeHar() error {
+	entries := make([]harEntry, 0, len(r.entries))
+	for _, e := range r.entries {
+		entries = append(entries, newHarEntry(e))
+	}
+	archive := harArchive{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "ybm-cli", Version: r.version},
+		Entries: entries,
+	}}
+	b, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(r.path, b, 0600); err != nil {
+		return fmt.Errorf("could not write trace file %s: %w", r.path, err)
+	}
+	return nil
+}
+
+type roundTripper struct {
+	next     http.RoundTripper
+	recorder *Recorder
+	warn     sync.Once
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := Entry{
+		StartedDateTime: time.Now(),
+		Method:          req.Method,
+		URL:             req.URL.String(),
+		RequestHeaders:  redactHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		entry.RequestBody = redact.String(string(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	entry.DurationMs = float64(time.Since(entry.StartedDateTime).Microseconds()) / 1000
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+		entry.StatusText = http.StatusText(resp.StatusCode)
+		entry.ResponseHeaders = redactHeaders(resp.Header)
+		entry.MimeType = resp.Header.Get("Content-Type")
+		if resp.Body != nil {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			entry.ResponseBody = redact.String(string(body))
+			if readErr != nil {
+				// The caller must not parse a truncated body
+				entry.Error = readErr.Error()
+				resp, err = nil, readErr
+			}
+		}
+	}
+
+	if recordErr := t.recorder.Record(entry); recordErr != nil {
+		t.warn.Do(func() {
+			logrus.Warnf("Unable to record HTTP trace: %v\n", recordErr)
+		})
+	}
+	return resp, err
+}
+
+func redactHeaders(header http.Header) []Header {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	headers := []Header{}
+	for _, name := range names {
+		for _, value := range header[name] {
+			for _, sensitive := range sensitiveHeaders {
+				if strings.EqualFold(name, sensitive) {
+					value = redact.Mask
+					break
+				}
+			}
+			headers = append(headers, Header{Name: name, Value: value})
+		}
+	}
+	return headers
+}
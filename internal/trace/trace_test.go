@@ -0,0 +1,126 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trace_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/yugabyte/ybm-cli/internal/redact"
+	"github.com/yugabyte/ybm-cli/internal/trace"
+)
+
+var _ = Describe("Trace", func() {
+	var (
+		server *ghttp.Server
+		dir    string
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodPost, "/api/public/v1/clusters"),
+			ghttp.VerifyJSON(`{"name":"c1","password":"s3cr3t"}`),
+			ghttp.RespondWith(http.StatusCreated, `{"data":{"id":"123","secret_key":"abc"}}`, http.Header{"Content-Type": []string{"application/json"}}),
+		))
+		dir = GinkgoT().TempDir()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	doRequest := func(recorder *trace.Recorder) string {
+		client := &http.Client{Transport: recorder.Transport(nil)}
+		req, err := http.NewRequest(http.MethodPost, server.URL()+"/api/public/v1/clusters", strings.NewReader(`{"name":"c1","password":"s3cr3t"}`))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer my-token")
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("should record JSON lines with redacted headers and bodies", func() {
+		path := filepath.Join(dir, "trace.jsonl")
+		recorder, err := trace.NewRecorder(path, "v1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		body := doRequest(recorder)
+		Expect(recorder.Close()).To(Succeed())
+		Expect(body).To(ContainSubstring(`"secret_key":"abc"`))
+
+		file, err := os.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		Expect(scanner.Scan()).To(BeTrue())
+		var entry trace.Entry
+		Expect(json.Unmarshal(scanner.Bytes(), &entry)).To(Succeed())
+		Expect(entry.Method).To(Equal(http.MethodPost))
+		Expect(entry.Status).To(Equal(http.StatusCreated))
+		Expect(entry.RequestHeaders).To(ContainElement(trace.Header{Name: "Authorization", Value: redact.Mask}))
+		Expect(entry.RequestBody).ToNot(ContainSubstring("s3cr3t"))
+		Expect(entry.ResponseBody).ToNot(ContainSubstring("abc"))
+		Expect(scanner.Scan()).To(BeFalse())
+	})
+
+	It("should write a valid HAR archive", func() {
+		path := filepath.Join(dir, "out.har")
+		recorder, err := trace.NewRecorder(path, "v1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		doRequest(recorder)
+		Expect(recorder.Close()).To(Succeed())
+
+		b, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		var archive map[string]map[string]interface{}
+		Expect(json.Unmarshal(b, &archive)).To(Succeed())
+		Expect(archive["log"]["version"]).To(Equal("1.2"))
+		Expect(archive["log"]["entries"]).To(HaveLen(1))
+		Expect(string(b)).ToNot(ContainSubstring("my-token"))
+	})
+
+	It("should return the error of a truncated response body", func() {
+		server.SetHandler(0, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Length", "100")
+			w.Write([]byte(`{"data":`))
+		})
+		path := filepath.Join(dir, "trace.jsonl")
+		recorder, err := trace.NewRecorder(path, "v1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		client := &http.Client{Transport: recorder.Transport(nil)}
+		_, err = client.Post(server.URL()+"/api/public/v1/clusters", "application/json", strings.NewReader(`{"name":"c1","password":"s3cr3t"}`))
+		Expect(err).To(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+
+		b, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		var entry trace.Entry
+		Expect(json.Unmarshal(b, &entry)).To(Succeed())
+		Expect(entry.Error).ToNot(BeEmpty())
+	})
+})
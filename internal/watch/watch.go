@@ -0,0 +1,145 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package watch refreshes the output of list and describe commands with --watch
+package watch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/yugabyte/ybm-cli/internal/formatter"
+	"golang.org/x/term"
+)
+
+// DefaultInterval is the refresh interval of --watch without a value
+const DefaultInterval = 5 * time.Second
+
+// AddFlag adds --watch [interval] to cmd
+func AddFlag(cmd *cobra.Command) {
+	cmd.Flags().Duration("watch", 0, fmt.Sprintf("[OPTIONAL] Refresh the output every interval (default %s) until interrupted, highlighting changed rows. Only changed records are printed when the output is not a terminal or with -o ndjson.", DefaultInterval))
+	cmd.Flags().Lookup("watch").NoOptDefVal = DefaultInterval.String()
+}
+
+// Interval returns the --watch interval of cmd, zero without --watch. Since the
+// flag value is optional, "--watch 10s" leaves the interval in args.
+func Interval(cmd *cobra.Command, args []string) (time.Duration, error) {
+	if cmd.Flags().Lookup("watch") == nil || !cmd.Flags().Changed("watch") {
+		return 0, nil
+	}
+	interval, _ := cmd.Flags().GetDuration("watch")
+	if len(args) == 1 {
+		d, err := time.ParseDuration(args[0])
+		if err != nil {
+			return 0, fmt.Errorf("unexpected argument '%s', --watch takes an interval such as 10s", args[0])
+		}
+		interval = d
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("--watch interval must be positive, got %s", interval)
+	}
+	return interval, nil
+}
+
+// Run calls render once with stdout, or with --watch every interval until
+// interrupted. On a terminal the output is redrawn in place with the changed
+// lines highlighted, otherwise only the lines which changed are printed.
+func Run(cmd *cobra.Command, args []string, render func(out io.Writer) error) error {
+	interval, err := Interval(cmd, args)
+	if err != nil {
+		return err
+	}
+	if interval == 0 {
+		return render(os.Stdout)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	w := &watcher{
+		out:    os.Stdout,
+		title:  fmt.Sprintf("Every %s: %s", interval, cmd.CommandPath()),
+		redraw: term.IsTerminal(int(os.Stdout.Fd())) && viper.GetString("output") != formatter.NDJSONFormatKey,
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		var buffer bytes.Buffer
+		err := render(&buffer)
+		w.show(buffer.String(), err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+type watcher struct {
+	out      io.Writer
+	title    string
+	redraw   bool
+	previous map[string]bool
+}
+
+// show writes a refresh, comparing its lines with the previous one
+func (w *watcher) show(output string, err error) {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	changed := func(line string) bool {
+		return w.previous != nil && line != "" && !w.previous[line]
+	}
+
+	if w.redraw {
+		fmt.Fprint(w.out, "\033[H\033[2J")
+		fmt.Fprintf(w.out, "%s\t%s\n\n", w.title, time.Now().Format(time.RFC1123))
+		if err != nil {
+			fmt.Fprintln(w.out, formatter.Colorize(err.Error(), formatter.RED_COLOR))
+		}
+		for _, line := range lines {
+			if changed(line) {
+				fmt.Fprintln(w.out, color.New(color.FgYellow, color.Bold).Sprint("* ")+line)
+			} else {
+				fmt.Fprintln(w.out, "  "+line)
+			}
+		}
+	} else {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		for _, line := range lines {
+			if line != "" && (w.previous == nil || changed(line)) {
+				fmt.Fprintln(w.out, line)
+			}
+		}
+	}
+
+	if err != nil {
+		// Keep the previous lines so the next refresh shows what changed since
+		return
+	}
+	w.previous = map[string]bool{}
+	for _, line := range lines {
+		w.previous[line] = true
+	}
+}
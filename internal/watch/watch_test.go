@@ -0,0 +1,65 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package watch_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/yugabyte/ybm-cli/internal/watch"
+)
+
+var _ = Describe("Watch", func() {
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = &cobra.Command{Use: "list"}
+		watch.AddFlag(cmd)
+	})
+
+	It("should not watch without the flag", func() {
+		interval, err := watch.Interval(cmd, []string{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interval).To(BeZero())
+	})
+
+	It("should default the interval", func() {
+		Expect(cmd.ParseFlags([]string{"--watch"})).To(Succeed())
+		interval, err := watch.Interval(cmd, cmd.Flags().Args())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interval).To(Equal(watch.DefaultInterval))
+	})
+
+	It("should take the interval as a flag value or an argument", func() {
+		Expect(cmd.ParseFlags([]string{"--watch=2s"})).To(Succeed())
+		interval, err := watch.Interval(cmd, cmd.Flags().Args())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interval).To(Equal(2 * time.Second))
+
+		Expect(cmd.ParseFlags([]string{"--watch", "1m"})).To(Succeed())
+		interval, err = watch.Interval(cmd, cmd.Flags().Args())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interval).To(Equal(time.Minute))
+	})
+
+	It("should reject an argument which is not an interval", func() {
+		Expect(cmd.ParseFlags([]string{"--watch", "soon"})).To(Succeed())
+		_, err := watch.Interval(cmd, cmd.Flags().Args())
+		Expect(err).To(MatchError("unexpected argument 'soon', --watch takes an interval such as 10s"))
+	})
+})
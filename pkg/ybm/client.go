@@ -0,0 +1,161 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package ybm is a Go SDK for YugabyteDB Aeon built on the generated API
+// client. It offers the conveniences of the ybm CLI (name resolution, task
+// waiting, cluster and role spec building) to other Go programs: every
+// method takes a context and options structs, and returns errors instead of
+// exiting the process.
+package ybm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// DefaultHost is the YugabyteDB Aeon API host
+const DefaultHost = "cloud.yugabyte.com"
+
+// Options configures a Client
+type Options struct {
+	// Host of the API, with or without scheme. Defaults to DefaultHost over https.
+	Host string
+	// APIKey authenticates every request
+	APIKey string
+	// AccountID and ProjectID are looked up from the API key when empty
+	AccountID string
+	ProjectID string
+	// HTTPClient sends the requests, http.DefaultClient when nil
+	HTTPClient *http.Client
+	// UserAgent is sent with every request
+	UserAgent string
+}
+
+// Client is a YugabyteDB Aeon client scoped to an account and a project
+type Client struct {
+	api       *ybmclient.APIClient
+	AccountID string
+	ProjectID string
+}
+
+// New returns a client for opts, resolving the account and project of the API
+// key when they are not given
+func New(ctx context.Context, opts Options) (*Client, error) {
+	if opts.APIKey == "" {
+		return nil, errors.New("an API key is required")
+	}
+	host := opts.Host
+	if host == "" {
+		host = DefaultHost
+	}
+	u, err := ParseURL(host)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := ybmclient.NewConfiguration()
+	configuration.Host = u.Host
+	configuration.Scheme = u.Scheme
+	if opts.HTTPClient != nil {
+		configuration.HTTPClient = opts.HTTPClient
+	}
+	if opts.UserAgent != "" {
+		configuration.UserAgent = opts.UserAgent
+	}
+	configuration.AddDefaultHeader("Authorization", "Bearer "+opts.APIKey)
+
+	c := NewWithAPIClient(ybmclient.NewAPIClient(configuration), "", "")
+	if c.AccountID, err = c.ResolveAccountID(ctx, opts.AccountID); err != nil {
+		return nil, err
+	}
+	if c.ProjectID, err = c.ResolveProjectID(ctx, opts.ProjectID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewWithAPIClient wraps an already configured API client, the account and
+// project IDs are used as is
+func NewWithAPIClient(api *ybmclient.APIClient, accountID string, projectID string) *Client {
+	return &Client{api: api, AccountID: accountID, ProjectID: projectID}
+}
+
+// API returns the generated client, for the calls this package does not wrap
+func (c *Client) API() *ybmclient.APIClient {
+	return c.api
+}
+
+// ResolveAccountID returns accountID, or the account of the API key when empty
+func (c *Client) ResolveAccountID(ctx context.Context, accountID string) (string, error) {
+	if len(accountID) > 0 {
+		return accountID, nil
+	}
+	accountResp, _, err := c.api.AccountApi.GetCurrentAccount(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+	return accountResp.Data.Info.Id, nil
+}
+
+// ResolveProjectID returns projectID, or the only project of the account when empty
+func (c *Client) ResolveProjectID(ctx context.Context, projectID string) (string, error) {
+	if len(projectID) > 0 {
+		return projectID, nil
+	}
+	accountResp, _, err := c.api.AccountApi.GetCurrentAccount(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+	projectData := accountResp.Data.Info.GetProjects()
+	if len(projectData) == 0 {
+		return "", fmt.Errorf("the account is not associated with any projects")
+	}
+	if len(projectData) > 1 {
+		return "", fmt.Errorf("the account is associated with multiple projects, please provide a project id")
+	}
+	return projectData[0].Info.Id, nil
+}
+
+// ParseURL parses an API host, defaulting to the https scheme
+func ParseURL(host string) (*url.URL, error) {
+	if !strings.HasPrefix(strings.ToLower(host), "http://") && !strings.HasPrefix(strings.ToLower(host), "https://") {
+		host = "https://" + host
+	}
+	endpoint, err := url.ParseRequestURI(host)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ybm server url (%s): %w", host, err)
+	}
+	return endpoint, nil
+}
+
+// ErrorDetail returns the detail of an API error, or err.Error() for other errors
+func ErrorDetail(err error) string {
+	if apiErr, ok := err.(ybmclient.GenericOpenAPIError); ok {
+		apiError := ybmclient.NewApiErrorWithDefaults()
+		if json.Unmarshal(apiErr.Body(), &apiError) == nil {
+			if d, ok := apiError.GetErrorOk(); ok {
+				return d.GetDetail()
+			}
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,404 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// RegionSpec describes the nodes of a cluster in one region
+type RegionSpec struct {
+	Region   string
+	NumNodes int32
+	// Vpc is the name or ID of the VPC of the region, optional
+	Vpc                        string
+	BackupReplicationGcpTarget string
+	// NumCores, DiskSizeGb and DiskIops default to the node configuration of the region when zero
+	NumCores   int32
+	DiskSizeGb int32
+	DiskIops   int32
+}
+
+// ParseRegionSpec parses the key=value pairs of a --region-info flag, such as
+// region, num-nodes, vpc, num-cores, disk-size-gb, disk-iops and
+// backup-replication-gcp-target
+func ParseRegionSpec(regionInfo map[string]string) (RegionSpec, error) {
+	spec := RegionSpec{
+		Region:                     regionInfo["region"],
+		Vpc:                        regionInfo["vpc"],
+		BackupReplicationGcpTarget: regionInfo["backup-replication-gcp-target"],
+	}
+	numNodes, err := strconv.ParseInt(regionInfo["num-nodes"], 10, 32)
+	if err != nil {
+		return spec, err
+	}
+	spec.NumNodes = int32(numNodes)
+	for key, value := range map[string]*int32{"num-cores": &spec.NumCores, "disk-size-gb": &spec.DiskSizeGb, "disk-iops": &spec.DiskIops} {
+		if raw, ok := regionInfo[key]; ok {
+			i, err := strconv.ParseInt(raw, 10, 32)
+			if err != nil {
+				return spec, fmt.Errorf("Unable to parse %s integer in %s", key, spec.Region)
+			}
+			*value = int32(i)
+		}
+	}
+	return spec, nil
+}
+
+// NodeConfigurationsFunc returns the node configurations available by region
+type NodeConfigurationsFunc func(ctx context.Context, cloud string, tier string, regions []string, geoPartitioned bool) (map[string][]ybmclient.NodeConfigurationResponseItem, error)
+
+// ClusterSpecOptions describes the cluster built by BuildClusterSpec. Empty
+// fields are left to the API defaults.
+type ClusterSpecOptions struct {
+	Name          string
+	CloudProvider string
+	// Tier is either Sandbox or Dedicated
+	Tier                string
+	FaultTolerance      string
+	NumFaultsToTolerate *int32
+	// ClusterType is SYNCHRONOUS or GEO_PARTITIONED
+	ClusterType        string
+	PreferredRegion    string
+	DefaultRegion      string
+	EnterpriseSecurity *bool
+	// DatabaseVersion is the name of a release track, ignored when TrackID is set
+	DatabaseVersion string
+	TrackID         string
+	Regions         []RegionSpec
+	// NodeConfigurations by region. When nil they are looked up with
+	// NodeConfigurationsFunc, or Client.NodeConfigurations without one.
+	NodeConfigurations     map[string][]ybmclient.NodeConfigurationResponseItem
+	NodeConfigurationsFunc NodeConfigurationsFunc
+}
+
+// BuildClusterSpec returns the spec of a cluster, resolving VPC names, the
+// database version and the node configuration of every region
+func (c *Client) BuildClusterSpec(ctx context.Context, opts ClusterSpecOptions) (*ybmclient.ClusterSpec, error) {
+	if len(opts.Regions) == 0 {
+		return nil, fmt.Errorf("region info must be provided")
+	}
+
+	var clusterRegionInfo []ybmclient.ClusterRegionInfo
+	var regions []string
+	totalNodes := 0
+	regionNodeInfoMap := map[string]*ybmclient.OptionalClusterNodeInfo{}
+	for _, regionSpec := range opts.Regions {
+		region := regionSpec.Region
+		totalNodes += int(regionSpec.NumNodes)
+		cloudInfo := *ybmclient.NewCloudInfoWithDefaults()
+		cloudInfo.SetRegion(region)
+		if opts.CloudProvider != "" {
+			cloudInfo.SetCode(ybmclient.CloudEnum(opts.CloudProvider))
+		}
+		info := *ybmclient.NewClusterRegionInfo(
+			*ybmclient.NewPlacementInfo(cloudInfo, regionSpec.NumNodes),
+		)
+		if regionSpec.Vpc != "" {
+			vpcID, _, err := c.VpcID(ctx, regionSpec.Vpc)
+			if err != nil {
+				return nil, err
+			}
+			info.PlacementInfo.SetVpcId(vpcID)
+		}
+		if opts.ClusterType == "GEO_PARTITIONED" {
+			info.PlacementInfo.SetMultiZone(true)
+		}
+		info.SetIsDefault(false)
+		if regionSpec.BackupReplicationGcpTarget != "" {
+			info.SetBackupReplicationGcpTarget(regionSpec.BackupReplicationGcpTarget)
+		}
+		clusterRegionInfo = append(clusterRegionInfo, info)
+
+		regionNodeInfo := ybmclient.NewOptionalClusterNodeInfo(0, 0, 0)
+		if regionSpec.NumCores != 0 {
+			regionNodeInfo.SetNumCores(regionSpec.NumCores)
+		}
+		if regionSpec.DiskSizeGb != 0 {
+			regionNodeInfo.SetDiskSizeGb(regionSpec.DiskSizeGb)
+		}
+		if regionSpec.DiskIops != 0 {
+			regionNodeInfo.SetDiskIops(regionSpec.DiskIops)
+		}
+		if _, ok := regionNodeInfoMap[region]; !ok {
+			regions = append(regions, region)
+		}
+		regionNodeInfoMap[region] = regionNodeInfo
+	}
+	if len(clusterRegionInfo) == 1 {
+		clusterRegionInfo[0].SetIsDefault(true)
+	}
+
+	// For the default tier which is FREE, isProduction has to be false
+	isProduction := false
+	clusterInfo := *ybmclient.NewClusterInfoWithDefaults()
+	if opts.Tier != "" {
+		clusterTier, err := ClusterTier(opts.Tier)
+		if err != nil {
+			return nil, err
+		}
+		if clusterTier == "PAID" {
+			isProduction = true
+		}
+		clusterInfo.SetClusterTier(ybmclient.ClusterTier(clusterTier))
+	}
+	if totalNodes != 0 {
+		clusterInfo.SetNumNodes(int32(totalNodes))
+	}
+	if opts.FaultTolerance != "" {
+		clusterInfo.SetFaultTolerance(ybmclient.ClusterFaultTolerance(opts.FaultTolerance))
+	}
+	if opts.PreferredRegion != "" {
+		if clusterInfo.GetFaultTolerance() != "REGION" {
+			return nil, fmt.Errorf("preferred region is allowed only for regional level fault tolerance")
+		}
+		if len(clusterRegionInfo) <= 1 {
+			return nil, fmt.Errorf("preferred region is allowed only if there is more than one region")
+		}
+		if err := SetPreferredRegion(clusterRegionInfo, opts.PreferredRegion); err != nil {
+			return nil, err
+		}
+	}
+	if opts.NumFaultsToTolerate != nil {
+		if valid, err := ValidateNumFaultsToTolerate(*opts.NumFaultsToTolerate, clusterInfo.GetFaultTolerance()); !valid {
+			return nil, err
+		}
+		clusterInfo.SetNumFaultsToTolerate(*opts.NumFaultsToTolerate)
+	}
+	if opts.EnterpriseSecurity != nil {
+		clusterInfo.SetEnterpriseSecurity(*opts.EnterpriseSecurity)
+	}
+	clusterInfo.SetIsProduction(isProduction)
+	if opts.ClusterType != "" {
+		clusterInfo.SetClusterType(ybmclient.ClusterType(opts.ClusterType))
+	}
+
+	tier := string(clusterInfo.GetClusterTier())
+	geoPartitioned := clusterInfo.GetClusterType() == "GEO_PARTITIONED"
+
+	regionNodeConfigsMap := opts.NodeConfigurations
+	if regionNodeConfigsMap == nil {
+		lookup := opts.NodeConfigurationsFunc
+		if lookup == nil {
+			lookup = c.NodeConfigurations
+		}
+		var err error
+		if regionNodeConfigsMap, err = lookup(ctx, opts.CloudProvider, tier, regions, geoPartitioned); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyNodeConfigurations(regions, regionNodeInfoMap, regionNodeConfigsMap); err != nil {
+		return nil, err
+	}
+
+	// Set per-region node info and cluster node info.
+	var currRegionNodeInfo *ybmclient.OptionalClusterNodeInfo = nil
+	for i, regionInfo := range clusterRegionInfo {
+		r := regionInfo.GetPlacementInfo().CloudInfo.Region
+		clusterRegionInfo[i].SetNodeInfo(*regionNodeInfoMap[r])
+		if currRegionNodeInfo != nil && !geoPartitioned && !optionalClusterNodeInfoEquals(*currRegionNodeInfo, clusterRegionInfo[i].GetNodeInfo()) {
+			// Asymmetric node configurations are only allowed for geo-partitioned clusters.
+			return nil, fmt.Errorf("Synchronous cluster regions must have identical node configurations")
+		}
+		currRegionNodeInfo = (&clusterRegionInfo[i]).NodeInfo.Get()
+	}
+
+	if opts.DefaultRegion != "" {
+		if clusterInfo.GetClusterType() != "GEO_PARTITIONED" {
+			return nil, fmt.Errorf("default region is allowed only for geo partitioned clusters")
+		}
+		if len(clusterRegionInfo) <= 1 {
+			return nil, fmt.Errorf("default region is allowed only if there is more than one region")
+		}
+		if err := SetDefaultRegion(clusterRegionInfo, opts.DefaultRegion); err != nil {
+			return nil, err
+		}
+	}
+
+	softwareInfo := *ybmclient.NewSoftwareInfoWithDefaults()
+	trackID := opts.TrackID
+	if trackID == "" && opts.DatabaseVersion != "" {
+		var err error
+		if trackID, err = c.TrackID(ctx, opts.DatabaseVersion); err != nil {
+			return nil, err
+		}
+	}
+	if trackID != "" {
+		softwareInfo.SetTrackId(trackID)
+	}
+
+	clusterSpec := ybmclient.NewClusterSpec(opts.Name, clusterInfo, softwareInfo)
+	clusterSpec.SetClusterRegionInfo(clusterRegionInfo)
+	return clusterSpec, nil
+}
+
+// applyNodeConfigurations sets the cores, memory and default disk size of every
+// region from the node configurations available there
+func applyNodeConfigurations(regions []string, regionNodeInfoMap map[string]*ybmclient.OptionalClusterNodeInfo, regionNodeConfigsMap map[string][]ybmclient.NodeConfigurationResponseItem) error {
+	// Create slice of region keys of node configurations response.
+	nodeConfigurationsRegions := make([]string, 0, len(regionNodeConfigsMap))
+	for k := range regionNodeConfigsMap {
+		nodeConfigurationsRegions = append(nodeConfigurationsRegions, k)
+	}
+	clusterNodeInfoWithDefaults := *ybmclient.NewClusterNodeInfoWithDefaults()
+	for _, r := range regions {
+		var nodeConfigs []ybmclient.NodeConfigurationResponseItem
+		if slices.Contains(nodeConfigurationsRegions, r) {
+			nodeConfigs = regionNodeConfigsMap[r]
+		} else if len(nodeConfigurationsRegions) > 0 {
+			// Requested region not found in node configurations map.
+			// In this case, the map key is a string of all (comma-separated) regions,
+			// and the value is a list of node configurations that are available in all regions.
+			// So, we just use look through the first map value to find a node configuration to use.
+			nodeConfigs = regionNodeConfigsMap[nodeConfigurationsRegions[0]]
+		}
+		requestedNodeInfo := regionNodeInfoMap[r]
+		requestedNumCores := requestedNodeInfo.GetNumCores()
+		if requestedNumCores == 0 {
+			requestedNumCores = clusterNodeInfoWithDefaults.GetNumCores()
+		}
+
+		var nodeConfig *ybmclient.NodeConfigurationResponseItem = nil
+		for i, nc := range nodeConfigs {
+			if nc.GetNumCores() == requestedNumCores {
+				nodeConfig = &nodeConfigs[i]
+				break
+			}
+		}
+		if nodeConfig == nil {
+			return fmt.Errorf("No instance type found with %d cores in region %s", requestedNumCores, r)
+		}
+		requestedNodeInfo.SetNumCores(nodeConfig.GetNumCores())
+		requestedNodeInfo.SetMemoryMb(nodeConfig.GetMemoryMb())
+		if requestedNodeInfo.GetDiskSizeGb() == 0 {
+			// User did not specify a disk size. Default to included disk size.
+			requestedNodeInfo.SetDiskSizeGb(nodeConfig.GetIncludedDiskSizeGb())
+		}
+	}
+	return nil
+}
+
+func optionalClusterNodeInfoEquals(left ybmclient.OptionalClusterNodeInfo, right ybmclient.OptionalClusterNodeInfo) bool {
+	return left.MemoryMb == right.MemoryMb && left.NumCores == right.NumCores && left.DiskSizeGb == right.DiskSizeGb && left.HasDiskIops() == right.HasDiskIops() && left.GetDiskIops() == right.GetDiskIops()
+}
+
+// NodeConfigurations returns the node configurations available by region for a new cluster
+func (c *Client) NodeConfigurations(ctx context.Context, cloud string, tier string, regions []string, geoPartitioned bool) (map[string][]ybmclient.NodeConfigurationResponseItem, error) {
+	instanceResp, _, err := c.NodeConfigurationsRequest(ctx, cloud, tier, regions, geoPartitioned).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return instanceResp.GetData(), nil
+}
+
+// NodeConfigurationsRequest returns the request listing the node configurations of regions.
+// Single-region, Azure and geo-partitioned clusters use single region configurations.
+func (c *Client) NodeConfigurationsRequest(ctx context.Context, cloud string, tier string, regions []string, geoPartitioned bool) ybmclient.ApiGetSupportedNodeConfigurationsByAccountRequest {
+	isMultiRegion := true
+	if len(regions) == 1 || cloud == "AZURE" || geoPartitioned {
+		isMultiRegion = false
+	}
+	return c.api.ClusterApi.GetSupportedNodeConfigurationsByAccount(ctx, c.AccountID).Cloud(cloud).Tier(tier).Regions(regions).IsMultiRegion(isMultiRegion)
+}
+
+// NodeConfigurationsForEdit returns the node configurations available by region to an existing cluster
+func (c *Client) NodeConfigurationsForEdit(ctx context.Context, clusterID string, regions []string) (map[string][]ybmclient.NodeConfigurationResponseItem, error) {
+	instanceResp, _, err := c.api.ClusterApi.GetSupportedNodeConfigurationsForClusterEdit(ctx, c.AccountID, c.ProjectID, clusterID).Regions(regions).PerRegion(true).ShowDisabled(false).ClusterType("PRIMARY").Execute()
+	if err != nil {
+		return nil, err
+	}
+	return instanceResp.GetData(), nil
+}
+
+// TrackID returns the ID of the release track called name
+func (c *Client) TrackID(ctx context.Context, name string) (string, error) {
+	tracksResp, _, err := c.api.SoftwareReleaseApi.ListTracks(ctx, c.AccountID).Execute()
+	if err != nil {
+		return "", err
+	}
+	return TrackIDFrom(tracksResp.GetData(), name)
+}
+
+// TrackIDFrom returns the ID of the track called name among tracks
+func TrackIDFrom(tracks []ybmclient.TrackData, name string) (string, error) {
+	for _, track := range tracks {
+		// Temporary backwards compatibility between Stable and Production tracks.
+		if track.Spec.GetName() == name || (track.Spec.GetName() == "Production" && name == "Stable") {
+			return track.Info.GetId(), nil
+		}
+	}
+	return "", fmt.Errorf("the database version doesn't exist")
+}
+
+// ClusterTier returns the API tier of a Sandbox or Dedicated cluster
+func ClusterTier(tier string) (string, error) {
+	switch tier {
+	case "Dedicated":
+		return "PAID", nil
+	case "Sandbox":
+		return "FREE", nil
+	}
+	return "", fmt.Errorf("the tier must be either 'Sandbox' or 'Dedicated'")
+}
+
+// SetPreferredRegion marks preferredRegion as the only affinitized region
+func SetPreferredRegion(clusterRegionInfo []ybmclient.ClusterRegionInfo, preferredRegion string) error {
+	if !slices.ContainsFunc(clusterRegionInfo, func(info ybmclient.ClusterRegionInfo) bool {
+		return info.PlacementInfo.CloudInfo.GetRegion() == preferredRegion
+	}) {
+		return fmt.Errorf("the preferred region is not found in the list of regions")
+	}
+	for i, info := range clusterRegionInfo {
+		clusterRegionInfo[i].SetIsAffinitized(info.PlacementInfo.CloudInfo.GetRegion() == preferredRegion)
+	}
+	return nil
+}
+
+// SetDefaultRegion marks defaultRegion as the default region of a geo-partitioned cluster
+func SetDefaultRegion(clusterRegionInfo []ybmclient.ClusterRegionInfo, defaultRegion string) error {
+	for i, info := range clusterRegionInfo {
+		if info.PlacementInfo.CloudInfo.GetRegion() == defaultRegion {
+			clusterRegionInfo[i].SetIsDefault(true)
+			return nil
+		}
+	}
+	return fmt.Errorf("the default region is not found in the list of regions")
+}
+
+// ValidateNumFaultsToTolerate checks the number of faults to tolerate against the fault tolerance level
+func ValidateNumFaultsToTolerate(numFaultsToTolerate int32, faultTolerance ybmclient.ClusterFaultTolerance) (bool, error) {
+	if numFaultsToTolerate < 0 || numFaultsToTolerate > 3 {
+		return false, fmt.Errorf("number of faults to tolerate must be between 0 and 3")
+	}
+	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_NONE && numFaultsToTolerate != 0 {
+		return false, fmt.Errorf("number of faults to tolerate must be 0 for fault tolerance level 'NONE'")
+	}
+	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_NODE && numFaultsToTolerate < 1 {
+		return false, fmt.Errorf("number of faults to tolerate must be greater than 0 for fault tolerance level 'NODE'")
+	}
+	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_REGION && numFaultsToTolerate < 1 {
+		return false, fmt.Errorf("number of faults to tolerate must be greater than 0 for fault tolerance level 'REGION'")
+	}
+	if faultTolerance == ybmclient.CLUSTERFAULTTOLERANCE_ZONE && numFaultsToTolerate != 1 {
+		return false, fmt.Errorf("number of faults to tolerate must be 1 for fault tolerance level 'ZONE'")
+	}
+	return true, nil
+}
@@ -0,0 +1,172 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// ClusterConditionKeys are the cluster properties a ClusterCondition can check
+var ClusterConditionKeys = []string{"state", "health", "nodes-up", "connection-pooling"}
+
+// ClusterCondition is a key=value condition on a cluster, such as state=ACTIVE,
+// health=HEALTHY, nodes-up=all or connection-pooling=ENABLED
+type ClusterCondition struct {
+	Key   string
+	Value string
+}
+
+func (c ClusterCondition) String() string {
+	return c.Key + "=" + c.Value
+}
+
+// ParseClusterCondition parses a key=value cluster condition. state and health
+// take the values of the API, nodes-up takes all or a number of nodes,
+// connection-pooling takes ENABLED or DISABLED.
+func ParseClusterCondition(condition string) (ClusterCondition, error) {
+	key, value, found := strings.Cut(condition, "=")
+	key = strings.ToLower(strings.TrimSpace(key))
+	value = strings.TrimSpace(value)
+	if !found || value == "" {
+		return ClusterCondition{}, fmt.Errorf("condition '%s' must be key=value with key one of %s", condition, strings.Join(ClusterConditionKeys, ", "))
+	}
+	switch key {
+	case "state":
+		value = strings.ToUpper(value)
+		// The error lists the valid states
+		if _, err := ybmclient.NewClusterStateFromValue(value); err != nil {
+			return ClusterCondition{}, err
+		}
+	case "health":
+		value = strings.ToUpper(value)
+		if _, err := ybmclient.NewClusterHealthStateFromValue(value); err != nil {
+			return ClusterCondition{}, err
+		}
+	case "nodes-up":
+		if !strings.EqualFold(value, "all") {
+			if n, err := strconv.Atoi(value); err != nil || n < 0 {
+				return ClusterCondition{}, fmt.Errorf("nodes-up must be all or a number of nodes, got '%s'", value)
+			}
+		}
+		value = strings.ToLower(value)
+	case "connection-pooling":
+		value = strings.ToUpper(value)
+		if value != "ENABLED" && value != "DISABLED" {
+			return ClusterCondition{}, fmt.Errorf("connection-pooling must be ENABLED or DISABLED, got '%s'", value)
+		}
+	default:
+		return ClusterCondition{}, fmt.Errorf("unknown condition key '%s', expected one of %s", key, strings.Join(ClusterConditionKeys, ", "))
+	}
+	return ClusterCondition{Key: key, Value: value}, nil
+}
+
+// ClusterStatus is the current value of every ClusterConditionKeys of a cluster
+type ClusterStatus map[string]string
+
+func (s ClusterStatus) String() string {
+	values := []string{}
+	for _, key := range ClusterConditionKeys {
+		if value, ok := s[key]; ok {
+			values = append(values, key+"="+value)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+// Satisfies is true when status meets every condition
+func (s ClusterStatus) Satisfies(conditions []ClusterCondition) bool {
+	for _, condition := range conditions {
+		if !s.satisfies(condition) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s ClusterStatus) satisfies(condition ClusterCondition) bool {
+	if condition.Key != "nodes-up" {
+		return s[condition.Key] == condition.Value
+	}
+	up, total, _ := strings.Cut(s["nodes-up"], "/")
+	if condition.Value == "all" {
+		return up == total && total != "0"
+	}
+	n, _ := strconv.Atoi(up)
+	want, _ := strconv.Atoi(condition.Value)
+	return n >= want
+}
+
+// GetClusterStatus reads the current status of a cluster. The nodes are only
+// requested when a condition is on nodes-up, which is reported as up/total.
+func (c *Client) GetClusterStatus(ctx context.Context, clusterID string, conditions []ClusterCondition) (ClusterStatus, error) {
+	resp, _, err := c.api.ClusterApi.GetCluster(ctx, c.AccountID, c.ProjectID, clusterID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	cluster := resp.GetData()
+	status := ClusterStatus{
+		"state":              strings.ToUpper(string(cluster.Info.GetState())),
+		"connection-pooling": "DISABLED",
+	}
+	if health, ok := cluster.Info.GetHealthInfoOk(); ok && health != nil {
+		status["health"] = strings.ToUpper(string(health.GetState()))
+	}
+	if cluster.Info.GetIsConnectionPoolingEnabled() {
+		status["connection-pooling"] = "ENABLED"
+	}
+
+	for _, condition := range conditions {
+		if condition.Key != "nodes-up" {
+			continue
+		}
+		nodes, _, err := c.api.ClusterApi.GetClusterNodes(ctx, c.AccountID, c.ProjectID, clusterID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		up := 0
+		for _, node := range nodes.GetData() {
+			if node.IsNodeUp {
+				up++
+			}
+		}
+		status["nodes-up"] = fmt.Sprintf("%d/%d", up, len(nodes.GetData()))
+		break
+	}
+	return status, nil
+}
+
+// WaitForCluster polls the cluster until its status satisfies every condition,
+// checking right away. onPoll, when not nil, is called with every status read.
+func (c *Client) WaitForCluster(ctx context.Context, clusterID string, conditions []ClusterCondition, opts PollOptions, onPoll func(status ClusterStatus)) (ClusterStatus, error) {
+	var status ClusterStatus
+	err := pollUntil(ctx, opts, true, func() (bool, error) {
+		var err error
+		status, err = c.GetClusterStatus(ctx, clusterID, conditions)
+		if err != nil {
+			return false, err
+		}
+		if onPoll != nil {
+			onPoll(status)
+		}
+		return status.Satisfies(conditions), nil
+	})
+	return status, err
+}
@@ -0,0 +1,72 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	"net/http"
+)
+
+// DefaultPageSize is the number of records requested per API call
+const DefaultPageSize = 100
+
+// PageOptions controls how many records a list call returns
+type PageOptions struct {
+	// Limit caps the number of records returned, 0 means no cap
+	Limit int
+	// PageSize is the number of records requested per API call
+	PageSize int
+	// All follows continuation tokens until the last page
+	All bool
+}
+
+// AllPages returns every record, as needed to find a resource by name
+var AllPages = PageOptions{All: true}
+
+// PageFetcher requests a single page. The token is empty for the first page,
+// the returned token is empty once the last page has been reached.
+type PageFetcher[T any] func(token string, pageSize int32) ([]T, string, *http.Response, error)
+
+// Paginate calls fetch until opts are satisfied. Without All or Limit only the
+// first page is returned, and more is true when other records are available.
+func Paginate[T any](opts PageOptions, fetch PageFetcher[T]) (records []T, more bool, r *http.Response, err error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if opts.Limit > 0 && opts.Limit < pageSize {
+		pageSize = opts.Limit
+	}
+
+	token := ""
+	for {
+		data, next, r, err := fetch(token, int32(pageSize))
+		if err != nil {
+			return records, false, r, err
+		}
+		records = append(records, data...)
+
+		if opts.Limit > 0 && len(records) >= opts.Limit {
+			return records[:opts.Limit], false, r, nil
+		}
+		if next == "" || next == token {
+			return records, false, r, nil
+		}
+		if !opts.All && opts.Limit == 0 {
+			return records, true, r, nil
+		}
+		token = next
+	}
+}
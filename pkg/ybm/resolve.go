@@ -0,0 +1,623 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// customRoleFeatureFlagDisabled is returned when custom roles are not enabled for the account
+const customRoleFeatureFlagDisabled = "Requested API not found"
+
+var idPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsId returns true when value is a resource ID rather than a name
+func IsId(value string) bool {
+	return idPattern.MatchString(strings.TrimSpace(value))
+}
+
+// NotFoundError is returned by the lookup methods when no resource has the given name
+type NotFoundError struct {
+	err error
+	// Suggestions are the existing names closest to the one given
+	Suggestions []string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.err.Error() + e.DidYouMean()
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.err
+}
+
+// DidYouMean returns ", did you mean x?" or an empty string without suggestions
+func (e *NotFoundError) DidYouMean() string {
+	if len(e.Suggestions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %s?", strings.Join(e.Suggestions, " or "))
+}
+
+// WithSuggestion turns err into a NotFoundError suggesting the candidates closest to name
+func WithSuggestion(err error, name string, candidates []string) error {
+	return &NotFoundError{err: err, Suggestions: Suggest(name, candidates)}
+}
+
+// Suggest returns up to three candidates close to name, closest first
+func Suggest(name string, candidates []string) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	type scored struct {
+		name     string
+		distance int
+	}
+	var matches []scored
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		if candidate == name || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		distance := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if distance <= maxDistance {
+			matches = append(matches, scored{candidate, distance})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+	suggestions := []string{}
+	for i := 0; i < len(matches) && i < 3; i++ {
+		suggestions = append(suggestions, matches[i].name)
+	}
+	return suggestions
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// findByName picks the record called name among the records of a list call.
+// An exact match wins and several exact matches are ambiguous. Otherwise a single
+// record is used since the API already filtered on the name.
+func findByName[T any](records []T, name string, nameOf func(T) string, idOf func(T) string, resource string) (T, bool, error) {
+	var exact []T
+	for _, record := range records {
+		if nameOf(record) == name {
+			exact = append(exact, record)
+		}
+	}
+	var zero T
+	switch {
+	case len(exact) > 1:
+		ids := make([]string, 0, len(exact))
+		for _, record := range exact {
+			ids = append(ids, idOf(record))
+		}
+		return zero, true, fmt.Errorf("%d %ss are named %s (%s), use the ID instead of the name", len(exact), resource, name, strings.Join(ids, ", "))
+	case len(exact) == 1:
+		return exact[0], true, nil
+	case len(records) == 1:
+		return records[0], true, nil
+	case len(records) > 1:
+		return zero, true, fmt.Errorf("%s matches several %ss (%s), use the exact name or the ID", name, resource, strings.Join(namesOf(records, nameOf), ", "))
+	}
+	return zero, false, nil
+}
+
+// findExact picks the record whose name or ID is value, for list calls that
+// cannot filter on the name. Several records with that name are ambiguous.
+func findExact[T any](records []T, value string, nameOf func(T) string, idOf func(T) string, resource string) (T, bool, error) {
+	for _, record := range records {
+		if IsId(value) && idOf(record) == value {
+			return record, true, nil
+		}
+	}
+	var exact []T
+	for _, record := range records {
+		if nameOf(record) == value {
+			exact = append(exact, record)
+		}
+	}
+	return findByName(exact, value, nameOf, idOf, resource)
+}
+
+// namesOf returns the names of records, for suggestions
+func namesOf[T any](records []T, nameOf func(T) string) []string {
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		names = append(names, nameOf(record))
+	}
+	return names
+}
+
+// suggestionsFrom lists candidate names for a not found error, an error here only
+// costs the suggestion
+func (c *Client) suggestionsFrom(ctx context.Context, kind ResourceKind) []string {
+	refs, err := c.Names(ctx, kind)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
+// ResourceKind is a type of resource that can be looked up by name
+type ResourceKind string
+
+const (
+	Clusters          ResourceKind = "clusters"
+	Roles             ResourceKind = "roles"
+	ApiKeys           ResourceKind = "api-keys"
+	Integrations      ResourceKind = "integrations"
+	MetricsExporters  ResourceKind = "metrics-exporters"
+	DrConfigs         ResourceKind = "dr-configs"
+	Vpcs              ResourceKind = "vpcs"
+	NetworkAllowLists ResourceKind = "network-allow-lists"
+	CdcSinks          ResourceKind = "cdc-sinks"
+	CdcStreams        ResourceKind = "cdc-streams"
+)
+
+// ResourceRef is the name and ID of a resource
+type ResourceRef struct {
+	Name string
+	ID   string
+}
+
+// Names lists the name and ID of every resource of kind
+func (c *Client) Names(ctx context.Context, kind ResourceKind) ([]ResourceRef, error) {
+	switch kind {
+	case Clusters:
+		clusters, _, err := c.listClusters(ctx, "")
+		return refsOf(clusters, clusterNameOf, clusterIdOf), err
+	case Roles:
+		roles, _, err := c.listRoles(ctx, "")
+		return refsOf(roles, roleNameOf, roleIdOf), err
+	case ApiKeys:
+		keys, _, err := c.listApiKeys(ctx, "")
+		return refsOf(keys, apiKeyNameOf, apiKeyIdOf), err
+	case Integrations:
+		resp, _, err := c.api.TelemetryProviderApi.ListTelemetryProviders(ctx, c.AccountID, c.ProjectID).Execute()
+		return refsOf(resp.GetData(), integrationNameOf, integrationIdOf), err
+	case MetricsExporters:
+		resp, _, err := c.api.MetricsExporterConfigApi.ListMetricsExporterConfigs(ctx, c.AccountID, c.ProjectID).Execute()
+		return refsOf(resp.GetData(), metricsExporterNameOf, metricsExporterIdOf), err
+	case DrConfigs:
+		resp, _, err := c.api.XclusterDrApi.ListAllXClusterDrInAccount(ctx, c.AccountID, c.ProjectID).Execute()
+		return refsOf(resp.GetData(), drNameOf, drIdOf), err
+	case Vpcs:
+		resp, _, err := c.api.NetworkApi.ListSingleTenantVpcs(ctx, c.AccountID, c.ProjectID).Execute()
+		return refsOf(resp.GetData(), vpcNameOf, vpcIdOf), err
+	case NetworkAllowLists:
+		resp, _, err := c.api.NetworkApi.ListNetworkAllowLists(ctx, c.AccountID, c.ProjectID).Execute()
+		return refsOf(resp.GetData(), nalNameOf, nalIdOf), err
+	case CdcSinks:
+		sinks, _, err := c.listCdcSinks(ctx, "")
+		return refsOf(sinks, cdcSinkNameOf, cdcSinkIdOf), err
+	case CdcStreams:
+		streams, _, err := c.listCdcStreams(ctx, "")
+		return refsOf(streams, cdcStreamNameOf, cdcStreamIdOf), err
+	}
+	return nil, fmt.Errorf("unknown resource kind %s", kind)
+}
+
+func refsOf[T any](records []T, nameOf func(T) string, idOf func(T) string) []ResourceRef {
+	refs := make([]ResourceRef, 0, len(records))
+	for _, record := range records {
+		refs = append(refs, ResourceRef{Name: nameOf(record), ID: idOf(record)})
+	}
+	return refs
+}
+
+// ClusterByName returns the cluster with the given name or ID
+func (c *Client) ClusterByName(ctx context.Context, clusterName string) (ybmclient.ClusterData, *http.Response, error) {
+	if IsId(clusterName) {
+		if clusterResp, r, err := c.api.ClusterApi.GetCluster(ctx, c.AccountID, c.ProjectID, clusterName).Execute(); err == nil {
+			return clusterResp.GetData(), r, nil
+		}
+	}
+	clusterData, r, err := c.listClusters(ctx, clusterName)
+	if err != nil {
+		return ybmclient.ClusterData{}, r, err
+	}
+
+	if cluster, found, err := findByName(clusterData, clusterName, clusterNameOf, clusterIdOf, "cluster"); found {
+		return cluster, r, err
+	}
+
+	notFound := fmt.Errorf("could not get cluster data for cluster name: %s", clusterName)
+	return ybmclient.ClusterData{}, r, WithSuggestion(notFound, clusterName, c.suggestionsFrom(ctx, Clusters))
+}
+
+// ClusterID returns the ID of the cluster with the given name or ID
+func (c *Client) ClusterID(ctx context.Context, clusterName string) (string, error) {
+	clusterData, _, err := c.ClusterByName(ctx, clusterName)
+	if err != nil {
+		return "", err
+	}
+	return clusterData.Info.GetId(), nil
+}
+
+// DrByName returns the DR config with the given name or ID
+func (c *Client) DrByName(ctx context.Context, drName string) (ybmclient.XClusterDrData, *http.Response, error) {
+	drResp, r, err := c.api.XclusterDrApi.ListAllXClusterDrInAccount(ctx, c.AccountID, c.ProjectID).Execute()
+	if err != nil {
+		return ybmclient.XClusterDrData{}, r, err
+	}
+	drData := drResp.GetData()
+
+	if drDatum, found, err := findExact(drData, drName, drNameOf, drIdOf, "DR config"); found {
+		return drDatum, r, err
+	}
+
+	notFound := fmt.Errorf("could not get data for the DR config %s", drName)
+	return ybmclient.XClusterDrData{}, r, WithSuggestion(notFound, drName, namesOf(drData, drNameOf))
+}
+
+// VpcID returns the ID of the VPC with the given name or ID
+func (c *Client) VpcID(ctx context.Context, vpcName string) (string, *http.Response, error) {
+	if IsId(vpcName) {
+		if _, r, err := c.api.NetworkApi.GetSingleTenantVpc(ctx, c.AccountID, c.ProjectID, vpcName).Execute(); err == nil {
+			return vpcName, r, nil
+		}
+	}
+	vpcResp, r, err := c.api.NetworkApi.ListSingleTenantVpcs(ctx, c.AccountID, c.ProjectID).Name(vpcName).Execute()
+	if err != nil {
+		return "", r, err
+	}
+
+	if vpc, found, err := findByName(vpcResp.GetData(), vpcName, vpcNameOf, vpcIdOf, "VPC"); found {
+		return vpc.Info.GetId(), r, err
+	}
+
+	notFound := fmt.Errorf("could not get vpc data for vpc name: %s", vpcName)
+	return "", r, WithSuggestion(notFound, vpcName, c.suggestionsFrom(ctx, Vpcs))
+}
+
+// NetworkAllowListID returns the ID of the network allow list with the given name or ID
+func (c *Client) NetworkAllowListID(ctx context.Context, networkAllowListName string) (string, *http.Response, error) {
+	nalResp, r, err := c.api.NetworkApi.ListNetworkAllowLists(ctx, c.AccountID, c.ProjectID).Execute()
+	if err != nil {
+		return "", r, err
+	}
+	nalData, found, err := findExact(nalResp.GetData(), networkAllowListName, nalNameOf, nalIdOf, "network allow list")
+	if !found {
+		notFound := fmt.Errorf("Unable to find NetworkAllowList %s", networkAllowListName)
+		return "", r, WithSuggestion(notFound, networkAllowListName, namesOf(nalResp.GetData(), nalNameOf))
+	}
+	if err != nil {
+		return "", r, err
+	}
+	return nalData.Info.GetId(), r, nil
+}
+
+// CdcStreamID returns the ID of the CDC stream with the given name or ID
+func (c *Client) CdcStreamID(ctx context.Context, cdcStreamName string) (string, *http.Response, error) {
+	if IsId(cdcStreamName) {
+		// Getting a stream needs its cluster, so the ID is checked against the
+		// streams of the account
+		if streamData, r, err := c.listCdcStreams(ctx, ""); err == nil {
+			if stream, found, err := findExact(streamData, cdcStreamName, cdcStreamNameOf, cdcStreamIdOf, "CDC stream"); found && err == nil {
+				return stream.Info.GetId(), r, nil
+			}
+		}
+	}
+	streamData, r, err := c.listCdcStreams(ctx, cdcStreamName)
+	if err != nil {
+		return "", r, err
+	}
+
+	if stream, found, err := findByName(streamData, cdcStreamName, cdcStreamNameOf, cdcStreamIdOf, "CDC stream"); found {
+		return stream.Info.GetId(), r, err
+	}
+
+	notFound := fmt.Errorf("couldn't find any cdcStream with the given name")
+	return "", r, WithSuggestion(notFound, cdcStreamName, c.suggestionsFrom(ctx, CdcStreams))
+}
+
+// CdcSinkID returns the ID of the CDC sink with the given name or ID
+func (c *Client) CdcSinkID(ctx context.Context, cdcSinkName string) (string, *http.Response, error) {
+	if IsId(cdcSinkName) {
+		if _, r, err := c.api.CdcApi.GetCdcSink(ctx, c.AccountID, cdcSinkName).Execute(); err == nil {
+			return cdcSinkName, r, nil
+		}
+	}
+	sinkData, r, err := c.listCdcSinks(ctx, cdcSinkName)
+	if err != nil {
+		return "", r, err
+	}
+
+	if sink, found, err := findByName(sinkData, cdcSinkName, cdcSinkNameOf, cdcSinkIdOf, "CDC sink"); found {
+		return sink.Info.GetId(), r, err
+	}
+
+	notFound := fmt.Errorf("couldn't find any cdcSink with the given name")
+	return "", r, WithSuggestion(notFound, cdcSinkName, c.suggestionsFrom(ctx, CdcSinks))
+}
+
+// RoleByName returns the role with the given display name or ID. System roles
+// are searched when custom roles are not enabled for the account.
+func (c *Client) RoleByName(ctx context.Context, roleName string) (ybmclient.RoleData, *http.Response, error) {
+	if IsId(roleName) {
+		if roleResp, r, err := c.api.RoleApi.GetRole(ctx, c.AccountID, roleName).Execute(); err == nil {
+			return roleResp.GetData(), r, nil
+		}
+	}
+	roleData, r, err := c.listRoles(ctx, roleName)
+	if err != nil {
+		return ybmclient.RoleData{}, r, err
+	}
+
+	if role, found, err := findByName(roleData, roleName, roleNameOf, roleIdOf, "role"); found {
+		return role, r, err
+	}
+
+	notFound := fmt.Errorf("could not get role data for role name: %s", roleName)
+	return ybmclient.RoleData{}, r, WithSuggestion(notFound, roleName, c.suggestionsFrom(ctx, Roles))
+}
+
+// RoleID returns the ID of the role with the given display name or ID
+func (c *Client) RoleID(ctx context.Context, roleName string) (string, error) {
+	roleData, _, err := c.RoleByName(ctx, roleName)
+	if err != nil {
+		return "", err
+	}
+	return roleData.Info.GetId(), nil
+}
+
+// ApiKeyByName returns the API key with the given name
+func (c *Client) ApiKeyByName(ctx context.Context, name string) (ybmclient.ApiKeyData, *http.Response, error) {
+	keyData, r, err := c.listApiKeys(ctx, name)
+	if err != nil {
+		return ybmclient.ApiKeyData{}, r, err
+	}
+
+	if key, found, err := findByName(keyData, name, apiKeyNameOf, apiKeyIdOf, "API key"); found {
+		return key, r, err
+	}
+
+	notFound := fmt.Errorf("could not get API Key data for name: %s", name)
+	return ybmclient.ApiKeyData{}, r, WithSuggestion(notFound, name, c.suggestionsFrom(ctx, ApiKeys))
+}
+
+// ApiKeyID returns the ID of the API key with the given name or ID
+func (c *Client) ApiKeyID(ctx context.Context, name string) (string, error) {
+	if IsId(name) {
+		if keyData, _, err := c.listApiKeys(ctx, ""); err == nil {
+			if key, found, err := findExact(keyData, name, apiKeyNameOf, apiKeyIdOf, "API key"); found && err == nil {
+				return key.Info.GetId(), nil
+			}
+		}
+	}
+	keyData, _, err := c.ApiKeyByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return keyData.Info.GetId(), nil
+}
+
+// MetricsExporterConfigByName returns the metrics exporter config with the given name or ID
+func (c *Client) MetricsExporterConfigByName(ctx context.Context, configName string) (*ybmclient.MetricsExporterConfigurationData, *http.Response, error) {
+	resp, r, err := c.api.MetricsExporterConfigApi.ListMetricsExporterConfigs(ctx, c.AccountID, c.ProjectID).Execute()
+	if err != nil {
+		return nil, r, err
+	}
+
+	if metricsExporter, found, err := findExact(resp.GetData(), configName, metricsExporterNameOf, metricsExporterIdOf, "metrics exporter config"); found {
+		return &metricsExporter, r, err
+	}
+
+	notFound := fmt.Errorf("could not find config with name %s", configName)
+	return nil, r, WithSuggestion(notFound, configName, namesOf(resp.GetData(), metricsExporterNameOf))
+}
+
+// IntegrationByName returns the integration with the given name or ID
+func (c *Client) IntegrationByName(ctx context.Context, configName string) (*ybmclient.TelemetryProviderData, *http.Response, error) {
+	resp, r, err := c.api.TelemetryProviderApi.ListTelemetryProviders(ctx, c.AccountID, c.ProjectID).Execute()
+	if err != nil {
+		return nil, r, err
+	}
+
+	if tp, found, err := findExact(resp.GetData(), configName, integrationNameOf, integrationIdOf, "integration"); found {
+		return &tp, r, err
+	}
+
+	notFound := fmt.Errorf("could not find config with name %s", configName)
+	return nil, r, WithSuggestion(notFound, configName, namesOf(resp.GetData(), integrationNameOf))
+}
+
+// IntegrationID returns the ID of the integration with the given name or ID
+func (c *Client) IntegrationID(ctx context.Context, integrationName string) (string, error) {
+	if IsId(integrationName) {
+		// There is no API to get an integration, IntegrationByName checks the ID
+		// against the list
+		if tp, _, err := c.IntegrationByName(ctx, integrationName); err == nil {
+			return tp.GetInfo().Id, nil
+		}
+	}
+	integration, _, err := c.api.TelemetryProviderApi.ListTelemetryProviders(ctx, c.AccountID, c.ProjectID).Name(integrationName).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to get integration by name %s: %w", integrationName, err)
+	}
+
+	if tp, found, err := findByName(integration.GetData(), integrationName, integrationNameOf, integrationIdOf, "integration"); found {
+		return tp.GetInfo().Id, err
+	}
+
+	notFound := fmt.Errorf("no integrations found with name: %s", integrationName)
+	return "", WithSuggestion(notFound, integrationName, c.suggestionsFrom(ctx, Integrations))
+}
+
+// listClusters lists every cluster, or those matching name when not empty
+func (c *Client) listClusters(ctx context.Context, name string) ([]ybmclient.ClusterData, *http.Response, error) {
+	request := c.api.ClusterApi.ListClusters(ctx, c.AccountID, c.ProjectID)
+	if name != "" {
+		request = request.Name(name)
+	}
+	records, _, r, err := Paginate(AllPages, func(token string, pageSize int32) ([]ybmclient.ClusterData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+	return records, r, err
+}
+
+// listRoles lists every role, or those matching name when not empty. Only
+// system roles are listed when custom roles are not enabled for the account.
+func (c *Client) listRoles(ctx context.Context, name string) ([]ybmclient.RoleData, *http.Response, error) {
+	list := func(roleTypes string) ([]ybmclient.RoleData, *http.Response, error) {
+		request := c.api.RoleApi.ListRbacRoles(ctx, c.AccountID).RoleTypes(roleTypes)
+		if name != "" {
+			request = request.DisplayName(name)
+		}
+		records, _, r, err := Paginate(AllPages, func(token string, pageSize int32) ([]ybmclient.RoleData, string, *http.Response, error) {
+			if token != "" {
+				request = request.ContinuationToken(token)
+			}
+			resp, r, err := request.Limit(pageSize).Execute()
+			metadata := resp.GetMetadata()
+			return resp.GetData(), metadata.GetContinuationToken(), r, err
+		})
+		return records, r, err
+	}
+	roles, r, err := list("ALL")
+	if err != nil && strings.TrimSpace(ErrorDetail(err)) == customRoleFeatureFlagDisabled {
+		return list("SYSTEM")
+	}
+	return roles, r, err
+}
+
+// listApiKeys lists every API key, or those matching name when not empty
+func (c *Client) listApiKeys(ctx context.Context, name string) ([]ybmclient.ApiKeyData, *http.Response, error) {
+	request := c.api.AuthApi.ListApiKeys(ctx, c.AccountID)
+	if name != "" {
+		request = request.ApiKeyName(name)
+	}
+	records, _, r, err := Paginate(AllPages, func(token string, pageSize int32) ([]ybmclient.ApiKeyData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+	return records, r, err
+}
+
+// listCdcSinks lists every CDC sink, or those matching name when not empty
+func (c *Client) listCdcSinks(ctx context.Context, name string) ([]ybmclient.CdcSinkData, *http.Response, error) {
+	request := c.api.CdcApi.ListCdcSinks(ctx, c.AccountID)
+	if name != "" {
+		request = request.Name(name)
+	}
+	records, _, r, err := Paginate(AllPages, func(token string, pageSize int32) ([]ybmclient.CdcSinkData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+	return records, r, err
+}
+
+// listCdcStreams lists every CDC stream of the account, or those matching name when not empty
+func (c *Client) listCdcStreams(ctx context.Context, name string) ([]ybmclient.CdcStreamData, *http.Response, error) {
+	request := c.api.CdcApi.ListCdcStreamsForAccount(ctx, c.AccountID)
+	if name != "" {
+		request = request.Name(name)
+	}
+	records, _, r, err := Paginate(AllPages, func(token string, pageSize int32) ([]ybmclient.CdcStreamData, string, *http.Response, error) {
+		if token != "" {
+			request = request.ContinuationToken(token)
+		}
+		resp, r, err := request.Limit(pageSize).Execute()
+		metadata := resp.GetMetadata()
+		return resp.GetData(), metadata.GetContinuationToken(), r, err
+	})
+	return records, r, err
+}
+
+func clusterNameOf(c ybmclient.ClusterData) string { return c.Spec.Name }
+func clusterIdOf(c ybmclient.ClusterData) string   { return c.Info.GetId() }
+
+func vpcNameOf(v ybmclient.SingleTenantVpcDataResponse) string { return v.Spec.Name }
+func vpcIdOf(v ybmclient.SingleTenantVpcDataResponse) string   { return v.Info.GetId() }
+
+func roleNameOf(r ybmclient.RoleData) string { return r.Info.GetDisplayName() }
+func roleIdOf(r ybmclient.RoleData) string   { return r.Info.GetId() }
+
+func apiKeyNameOf(k ybmclient.ApiKeyData) string { return k.Spec.GetName() }
+func apiKeyIdOf(k ybmclient.ApiKeyData) string   { return k.Info.GetId() }
+
+func cdcSinkNameOf(s ybmclient.CdcSinkData) string { return string(s.Spec.GetName()) }
+func cdcSinkIdOf(s ybmclient.CdcSinkData) string   { return s.Info.GetId() }
+
+func cdcStreamNameOf(s ybmclient.CdcStreamData) string { return string(s.Spec.GetName()) }
+func cdcStreamIdOf(s ybmclient.CdcStreamData) string   { return s.Info.GetId() }
+
+func drNameOf(d ybmclient.XClusterDrData) string { return d.Spec.GetName() }
+func drIdOf(d ybmclient.XClusterDrData) string   { return d.Info.GetId() }
+
+func nalNameOf(n ybmclient.NetworkAllowListData) string { return n.Spec.Name }
+func nalIdOf(n ybmclient.NetworkAllowListData) string   { return n.Info.GetId() }
+
+func integrationNameOf(tp ybmclient.TelemetryProviderData) string { return tp.GetSpec().Name }
+func integrationIdOf(tp ybmclient.TelemetryProviderData) string   { return tp.GetInfo().Id }
+
+func metricsExporterNameOf(me ybmclient.MetricsExporterConfigurationData) string {
+	return me.GetSpec().Name
+}
+func metricsExporterIdOf(me ybmclient.MetricsExporterConfigurationData) string {
+	return me.GetInfo().Id
+}
@@ -0,0 +1,47 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// RoleSpecOptions describes the role built by BuildRoleSpec
+type RoleSpecOptions struct {
+	Name string
+	// Description is left unchanged when nil
+	Description *string
+	// Permissions maps a resource type to its operation groups
+	Permissions map[string][]string
+}
+
+// BuildRoleSpec returns the spec of a role
+func BuildRoleSpec(opts RoleSpecOptions) *ybmclient.RoleSpec {
+	var rolePermissions []ybmclient.ResourcePermissionInfo
+	for resource, ops := range opts.Permissions {
+		var operationGroups []ybmclient.ResourceOperationGroup
+		for _, op := range ops {
+			operationGroups = append(operationGroups, *ybmclient.NewResourceOperationGroup(ybmclient.ResourceOperationGroupEnum(op)))
+		}
+		rolePermissions = append(rolePermissions, *ybmclient.NewResourcePermissionInfo(ybmclient.ResourceTypeEnum(resource), operationGroups))
+	}
+
+	roleSpec := ybmclient.NewRoleSpec(opts.Name, rolePermissions)
+	if opts.Description != nil {
+		roleSpec.SetDescription(*opts.Description)
+	}
+	return roleSpec
+}
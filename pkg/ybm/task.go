@@ -0,0 +1,391 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// DefaultPollInterval is the time between two task status requests
+const DefaultPollInterval = 10 * time.Second
+
+var (
+	// ErrWaitTimeout is returned when the task did not complete in time
+	ErrWaitTimeout = errors.New("wait timeout, operation could still be on-going")
+	// ErrInterrupted is returned when the context is cancelled while waiting
+	ErrInterrupted = errors.New("receive interrupt signal, operation could still be on-going")
+)
+
+// PollOptions configures how often and how long to poll
+type PollOptions struct {
+	// Interval between two polls, DefaultPollInterval when zero
+	Interval time.Duration
+	// Backoff multiplies the interval after every poll, no backoff when 1 or less
+	Backoff float64
+	// MaxInterval caps the interval grown by Backoff, no cap when zero
+	MaxInterval time.Duration
+	// Timeout of the whole wait, no timeout when zero
+	Timeout time.Duration
+}
+
+// WaitOptions configures WaitForTask
+type WaitOptions struct {
+	PollOptions
+	// EntityType of the task, left empty for entities that do not need it such as VPCs
+	EntityType ybmclient.EntityTypeEnum
+	// CompletionStates end the wait, for example SUCCEEDED and FAILED
+	CompletionStates []string
+	// OnPoll is called after every poll with the latest task, nil when the entity
+	// has no such task, and its state
+	OnPoll func(task *ybmclient.TaskData, state string)
+}
+
+// WaitForTask polls the latest task of taskType on entityID until it reaches one
+// of opts.CompletionStates and returns that state. An entity without any such
+// task is considered SUCCEEDED.
+func (c *Client) WaitForTask(ctx context.Context, entityID string, taskType ybmclient.TaskTypeEnum, opts WaitOptions) (string, error) {
+	return c.poll(ctx, opts, false, func() (*ybmclient.TaskData, error) {
+		return c.LatestTask(ctx, entityID, opts.EntityType, taskType)
+	})
+}
+
+// WaitForTaskID polls the task with the given ID among the tasks matching filter
+// until it reaches one of opts.CompletionStates and returns that state. Unlike
+// WaitForTask, the task must exist and is checked right away, so a task that
+// already completed returns immediately.
+func (c *Client) WaitForTaskID(ctx context.Context, taskID string, filter TaskFilter, opts WaitOptions) (string, error) {
+	task, err := c.TaskByID(ctx, taskID, filter)
+	if err != nil {
+		return "", err
+	}
+	// Later polls only list the tasks of the same entity and type
+	filter = TaskFilter{
+		EntityType: task.Info.GetEntityType(),
+		EntityID:   task.Info.GetEntityId(),
+		TaskType:   task.Info.GetTaskType(),
+	}
+	first := &task
+	return c.poll(ctx, opts, true, func() (*ybmclient.TaskData, error) {
+		if first != nil {
+			task, first = *first, nil
+			return &task, nil
+		}
+		task, err := c.TaskByID(ctx, taskID, filter)
+		return &task, err
+	})
+}
+
+// poll fetches the task until it reaches one of opts.CompletionStates
+func (c *Client) poll(ctx context.Context, opts WaitOptions, immediate bool, fetch func() (*ybmclient.TaskData, error)) (string, error) {
+	state := ""
+	err := pollUntil(ctx, opts.PollOptions, immediate, func() (bool, error) {
+		task, err := fetch()
+		if err != nil {
+			return false, err
+		}
+		state = "SUCCEEDED"
+		if task != nil {
+			state = task.Info.GetState()
+		}
+		if opts.OnPoll != nil {
+			opts.OnPoll(task, state)
+		}
+		return slices.Contains(opts.CompletionStates, state), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return state, nil
+}
+
+// pollUntil calls done after every interval, right away when immediate, until it
+// returns true or an error
+func pollUntil(ctx context.Context, opts PollOptions, immediate bool, done func() (bool, error)) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	var timeout <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	if immediate {
+		if ok, err := done(); err != nil || ok {
+			return interruption(ctx, err)
+		}
+	}
+	next := time.NewTimer(interval)
+	defer next.Stop()
+	for {
+		select {
+		case <-timeout:
+			return ErrWaitTimeout
+		case <-ctx.Done():
+			return interruption(ctx, ctx.Err())
+		case <-next.C:
+			if ok, err := done(); err != nil || ok {
+				return interruption(ctx, err)
+			}
+			interval = nextInterval(interval, opts)
+			next.Reset(interval)
+		}
+	}
+}
+
+// interruption returns ErrWaitTimeout or ErrInterrupted in place of err once ctx
+// is done, as a request cancelled by ctx fails with its own error
+func interruption(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrWaitTimeout
+	}
+	return ErrInterrupted
+}
+
+// nextInterval grows interval by opts.Backoff up to opts.MaxInterval
+func nextInterval(interval time.Duration, opts PollOptions) time.Duration {
+	if opts.Backoff <= 1 {
+		return interval
+	}
+	interval = time.Duration(float64(interval) * opts.Backoff)
+	if opts.MaxInterval > 0 && interval > opts.MaxInterval {
+		interval = opts.MaxInterval
+	}
+	return interval
+}
+
+// LatestTask returns the latest task of taskType on entityID, or nil when there is none
+func (c *Client) LatestTask(ctx context.Context, entityID string, entityType ybmclient.EntityTypeEnum, taskType ybmclient.TaskTypeEnum) (*ybmclient.TaskData, error) {
+	request := c.api.TaskApi.ListTasks(ctx, c.AccountID).TaskType(taskType).ProjectId(c.ProjectID).EntityId(entityID).Limit(1)
+	if len(entityType) > 0 {
+		request = request.EntityType(entityType)
+	}
+	taskList, _, err := request.Execute()
+	if err != nil {
+		return nil, err
+	}
+	if tasks := taskList.GetData(); len(tasks) > 0 {
+		return &tasks[0], nil
+	}
+	return nil, nil
+}
+
+// TaskFilter selects the tasks returned by ListTasks. Empty fields match every task.
+type TaskFilter struct {
+	EntityType ybmclient.EntityTypeEnum
+	EntityID   string
+	TaskType   ybmclient.TaskTypeEnum
+	// States match case-insensitively, for example IN_PROGRESS or FAILED
+	States []string
+	// Since drops the tasks created before it
+	Since time.Time
+}
+
+func (f TaskFilter) matches(task ybmclient.TaskData) bool {
+	if len(f.States) > 0 && !slices.ContainsFunc(f.States, func(state string) bool {
+		return strings.EqualFold(state, task.Info.GetState())
+	}) {
+		return false
+	}
+	if !f.Since.IsZero() {
+		createdOn, err := time.Parse(time.RFC3339Nano, task.Info.GetCreatedOn())
+		if err != nil || createdOn.Before(f.Since) {
+			return false
+		}
+	}
+	return true
+}
+
+// before tells whether the task was created before Since, in which case so
+// were the tasks after it, as the API lists the most recent tasks first
+func (f TaskFilter) before(task ybmclient.TaskData) bool {
+	if f.Since.IsZero() {
+		return false
+	}
+	createdOn, err := time.Parse(time.RFC3339Nano, task.Info.GetCreatedOn())
+	return err == nil && createdOn.Before(f.Since)
+}
+
+// filtersPages tells whether tasks returned by the API are dropped by matches
+func (f TaskFilter) filtersPages() bool {
+	return len(f.States) > 0 || !f.Since.IsZero()
+}
+
+// TaskPages returns a PageFetcher listing the tasks of the project matching filter.
+// States and Since are filtered on each page since the API cannot filter on them,
+// so a page is fetched from as many API pages as needed to fill it, stopping at
+// the first API page reaching tasks created before Since.
+func (c *Client) TaskPages(ctx context.Context, filter TaskFilter) PageFetcher[ybmclient.TaskData] {
+	request := c.api.TaskApi.ListTasks(ctx, c.AccountID).ProjectId(c.ProjectID)
+	if len(filter.EntityType) > 0 {
+		request = request.EntityType(filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		request = request.EntityId(filter.EntityID)
+	}
+	if len(filter.TaskType) > 0 {
+		request = request.TaskType(filter.TaskType)
+	}
+	return func(token string, pageSize int32) ([]ybmclient.TaskData, string, *http.Response, error) {
+		tasks := []ybmclient.TaskData{}
+		for {
+			if token != "" {
+				request = request.ContinuationToken(token)
+			}
+			resp, r, err := request.Limit(pageSize).Execute()
+			if err != nil {
+				return tasks, "", r, err
+			}
+			data := resp.GetData()
+			for _, task := range data {
+				if filter.matches(task) {
+					tasks = append(tasks, task)
+				}
+			}
+			metadata := resp.GetMetadata()
+			next := metadata.GetContinuationToken()
+			if next == token || (len(data) > 0 && filter.before(data[len(data)-1])) {
+				next = ""
+			}
+			if !filter.filtersPages() || len(tasks) >= int(pageSize) || next == "" {
+				return tasks, next, r, nil
+			}
+			token = next
+		}
+	}
+}
+
+// ListTasks lists the tasks of the project matching filter, more is true when
+// opts stopped before the last page
+func (c *Client) ListTasks(ctx context.Context, filter TaskFilter, opts PageOptions) (tasks []ybmclient.TaskData, more bool, err error) {
+	tasks, more, _, err = Paginate(opts, c.TaskPages(ctx, filter))
+	return tasks, more, err
+}
+
+// TaskByID returns the task with the given ID among the tasks matching filter,
+// listing them until it is found since the API cannot get a task by its ID
+func (c *Client) TaskByID(ctx context.Context, taskID string, filter TaskFilter) (ybmclient.TaskData, error) {
+	fetch := c.TaskPages(ctx, filter)
+	token := ""
+	for {
+		tasks, next, _, err := fetch(token, DefaultPageSize)
+		if err != nil {
+			return ybmclient.TaskData{}, err
+		}
+		for _, task := range tasks {
+			if task.Info.GetId() == taskID {
+				return task, nil
+			}
+		}
+		if next == "" || next == token {
+			return ybmclient.TaskData{}, &NotFoundError{err: fmt.Errorf("could not find task %s", taskID)}
+		}
+		token = next
+	}
+}
+
+// TaskPercentComplete returns the average completion of the actions of a task, and
+// false when the task reports no action
+func TaskPercentComplete(task ybmclient.TaskData) (int, bool) {
+	progress, ok := task.Info.GetTaskProgressInfoOk()
+	if !ok || progress == nil || len(progress.GetActions()) == 0 {
+		return 0, false
+	}
+	total := 0
+	for _, action := range progress.GetActions() {
+		total += int(action.GetPercentComplete())
+	}
+	return total / len(progress.GetActions()), true
+}
+
+// ActionProgress is the completion of one action of a task
+type ActionProgress struct {
+	Name            string `json:"name"`
+	PercentComplete int    `json:"percent_complete"`
+}
+
+// TaskEvent describes a task at one poll, for structured progress output
+type TaskEvent struct {
+	Time       time.Time `json:"time"`
+	TaskID     string    `json:"task_id,omitempty"`
+	TaskType   string    `json:"task_type,omitempty"`
+	EntityType string    `json:"entity_type,omitempty"`
+	EntityID   string    `json:"entity_id,omitempty"`
+	State      string    `json:"state"`
+	// PercentComplete is the average of the actions, nil when the task reports none
+	PercentComplete *int             `json:"percent_complete,omitempty"`
+	Actions         []ActionProgress `json:"actions,omitempty"`
+	// Error holds the failure details of a FAILED task
+	Error string `json:"error,omitempty"`
+}
+
+// NewTaskEvent describes task, nil when the entity has no such task, in the given state
+func NewTaskEvent(task *ybmclient.TaskData, state string) TaskEvent {
+	event := TaskEvent{Time: time.Now().UTC(), State: state}
+	if task == nil {
+		return event
+	}
+	event.TaskID = task.Info.GetId()
+	event.TaskType = string(task.Info.GetTaskType())
+	event.EntityType = string(task.Info.GetEntityType())
+	event.EntityID = task.Info.GetEntityId()
+	if percent, ok := TaskPercentComplete(*task); ok {
+		event.PercentComplete = &percent
+	}
+	if progress, ok := task.Info.GetTaskProgressInfoOk(); ok && progress != nil {
+		for _, action := range progress.GetActions() {
+			event.Actions = append(event.Actions, ActionProgress{Name: action.GetName(), PercentComplete: int(action.GetPercentComplete())})
+		}
+	}
+	if state == "FAILED" {
+		event.Error = TaskFailureDetails(*task)
+	}
+	return event
+}
+
+// SameProgress is true when both events have the same task, state and action
+// completion, whatever their time
+func (e TaskEvent) SameProgress(other TaskEvent) bool {
+	return e.TaskID == other.TaskID && e.State == other.State && slices.Equal(e.Actions, other.Actions)
+}
+
+// TaskFailureDetails returns the actions of a task which did not complete, as
+// the task explains no failure otherwise. It is empty when the task reports no
+// action.
+func TaskFailureDetails(task ybmclient.TaskData) string {
+	details := []string{}
+	if progress, ok := task.Info.GetTaskProgressInfoOk(); ok && progress != nil {
+		for _, action := range progress.GetActions() {
+			if action.GetPercentComplete() < 100 {
+				details = append(details, fmt.Sprintf("%s stopped at %d%%", action.GetName(), action.GetPercentComplete()))
+			}
+		}
+	}
+	return strings.Join(details, "; ")
+}
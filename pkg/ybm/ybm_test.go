@@ -0,0 +1,127 @@
+// Licensed to Yugabyte, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ybm_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/yugabyte/ybm-cli/pkg/ybm"
+	ybmclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+var _ = Describe("Ybm", func() {
+	Context("When resolving a resource name", func() {
+		It("should recognise IDs", func() {
+			Expect(ybm.IsId("5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8")).To(BeTrue())
+			Expect(ybm.IsId("stunning-sole")).To(BeFalse())
+		})
+		It("should suggest the closest names first", func() {
+			candidates := []string{"prod-west-1", "prod-east-1", "staging", "prod-east-2"}
+			Expect(ybm.Suggest("prod-east1", candidates)).To(Equal([]string{"prod-east-1", "prod-east-2", "prod-west-1"}))
+			Expect(ybm.Suggest("analytics", candidates)).To(BeEmpty())
+		})
+		It("should append the suggestions to a not found error", func() {
+			err := ybm.WithSuggestion(errors.New("could not find prod-est-1"), "prod-est-1", []string{"prod-east-1", "dev"})
+			Expect(err).To(MatchError("could not find prod-est-1, did you mean prod-east-1?"))
+			var notFound *ybm.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Suggestions).To(Equal([]string{"prod-east-1"}))
+		})
+	})
+
+	Context("When building a cluster spec", func() {
+		It("should parse the region info", func() {
+			spec, err := ybm.ParseRegionSpec(map[string]string{"region": "us-west-2", "num-nodes": "3", "num-cores": "4", "vpc": "prod"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec).To(Equal(ybm.RegionSpec{Region: "us-west-2", NumNodes: 3, NumCores: 4, Vpc: "prod"}))
+		})
+		It("should reject a region info that is not a number", func() {
+			_, err := ybm.ParseRegionSpec(map[string]string{"region": "us-west-2", "num-nodes": "3", "disk-size-gb": "large"})
+			Expect(err).To(MatchError("Unable to parse disk-size-gb integer in us-west-2"))
+		})
+		It("should map the cluster tiers", func() {
+			Expect(ybm.ClusterTier("Dedicated")).To(Equal("PAID"))
+			Expect(ybm.ClusterTier("Sandbox")).To(Equal("FREE"))
+			_, err := ybm.ClusterTier("Free")
+			Expect(err).To(MatchError("the tier must be either 'Sandbox' or 'Dedicated'"))
+		})
+		It("should validate the number of faults to tolerate", func() {
+			valid, err := ybm.ValidateNumFaultsToTolerate(2, "REGION")
+			Expect(valid).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+			valid, err = ybm.ValidateNumFaultsToTolerate(2, "ZONE")
+			Expect(valid).To(BeFalse())
+			Expect(err).To(MatchError("number of faults to tolerate must be 1 for fault tolerance level 'ZONE'"))
+		})
+	})
+
+	Context("When reporting task progress", func() {
+		var task ybmclient.TaskData
+
+		BeforeEach(func() {
+			task = ybmclient.TaskData{}
+			Expect(json.Unmarshal([]byte(`{"info": {"id": "e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1", "task_type": "EDIT_CLUSTER", "entity_type": "CLUSTER", "entity_id": "5f80730f-ba3f-4f7e-8c01-f8fa4c90dad8", "state": "FAILED", "task_progress_info": {"actions": [{"name": "Provisioning nodes", "percent_complete": 100}, {"name": "Configuring database", "percent_complete": 40}]}}}`), &task)).To(Succeed())
+		})
+
+		It("should describe the task and its actions", func() {
+			event := ybm.NewTaskEvent(&task, "IN_PROGRESS")
+			Expect(event.TaskID).To(Equal("e8a4ef2a-3a7c-4fd5-9f5b-c4f7a2e4b0d1"))
+			Expect(*event.PercentComplete).To(Equal(70))
+			Expect(event.Actions).To(HaveLen(2))
+			Expect(event.Error).To(BeEmpty())
+			Expect(event.SameProgress(ybm.NewTaskEvent(&task, "IN_PROGRESS"))).To(BeTrue())
+			Expect(event.SameProgress(ybm.NewTaskEvent(&task, "FAILED"))).To(BeFalse())
+		})
+
+		It("should report the actions which did not complete on failure", func() {
+			Expect(ybm.NewTaskEvent(&task, "FAILED").Error).To(Equal("Configuring database stopped at 40%"))
+		})
+
+		It("should describe a missing task by its state only", func() {
+			event := ybm.NewTaskEvent(nil, "SUCCEEDED")
+			Expect(event.TaskID).To(BeEmpty())
+			Expect(event.PercentComplete).To(BeNil())
+		})
+	})
+
+	Context("When waiting for cluster conditions", func() {
+		It("should parse conditions", func() {
+			condition, err := ybm.ParseClusterCondition("state=active")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(condition).To(Equal(ybm.ClusterCondition{Key: "state", Value: "ACTIVE"}))
+			_, err = ybm.ParseClusterCondition("nodes-up=some")
+			Expect(err).To(MatchError("nodes-up must be all or a number of nodes, got 'some'"))
+			_, err = ybm.ParseClusterCondition("state")
+			Expect(err).To(HaveOccurred())
+			_, err = ybm.ParseClusterCondition("state=actve")
+			Expect(err).To(MatchError(ContainSubstring("ACTIVE")))
+			_, err = ybm.ParseClusterCondition("health=fine")
+			Expect(err).To(MatchError(ContainSubstring("HEALTHY")))
+		})
+
+		It("should check the nodes up", func() {
+			all, _ := ybm.ParseClusterCondition("nodes-up=all")
+			two, _ := ybm.ParseClusterCondition("nodes-up=2")
+			status := ybm.ClusterStatus{"state": "ACTIVE", "nodes-up": "2/3"}
+			Expect(status.Satisfies([]ybm.ClusterCondition{two})).To(BeTrue())
+			Expect(status.Satisfies([]ybm.ClusterCondition{two, all})).To(BeFalse())
+			Expect(ybm.ClusterStatus{"nodes-up": "0/0"}.Satisfies([]ybm.ClusterCondition{all})).To(BeFalse())
+		})
+	})
+})